@@ -0,0 +1,140 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"strconv"
+)
+
+const (
+	teardownBusinessGroup     = "business group"
+	teardownEnvironment       = "environment"
+	teardownDeployment        = "deployment"
+	teardownHybridApplication = "hybrid application"
+	teardownCloudHubApp       = "CloudHub application"
+)
+
+//bgEnvironmentContents is an environment of a business group together with the applications deployed to it
+type bgEnvironmentContents struct {
+	env          sdk.Environment
+	deployments  []sdk.Deployment
+	hybridApps   []sdk.HybridApplication
+	cloudHubApps []sdk.CloudHubApplication
+}
+
+//teardownStep is the removal of a single object when force destroying a business group
+type teardownStep struct {
+	kind  string
+	orgID string
+	envID string
+	id    string
+	path  string
+}
+
+func (s teardownStep) String() string {
+	return fmt.Sprintf("%s %s (%s)", s.kind, s.path, s.id)
+}
+
+//planBGTeardown lists, bottom-up, what has to be deleted for the API to accept the deletion of bg: the sub
+//organizations first, then the applications of each environment, the environments and finally bg itself.
+//bg must come with its sub organizations, as returned by GetBusinessGroupHierarchy
+func planBGTeardown(bg sdk.BusinessGroup, path string, contents func(orgID string) ([]bgEnvironmentContents, error)) ([]teardownStep, error) {
+	steps := []teardownStep{}
+
+	for _, sub := range bg.SubOrganizations {
+		subSteps, err := planBGTeardown(sub, path+"/"+sub.Name, contents)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, subSteps...)
+	}
+
+	envs, err := contents(bg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range envs {
+		envPath := path + "/" + c.env.Name
+
+		for _, deployment := range c.deployments {
+			steps = append(steps, teardownStep{teardownDeployment, bg.ID, c.env.ID, deployment.ID, envPath + "/" + deployment.Name})
+		}
+
+		for _, app := range c.cloudHubApps {
+			steps = append(steps, teardownStep{teardownCloudHubApp, bg.ID, c.env.ID, app.Domain, envPath + "/" + app.Domain})
+		}
+
+		for _, app := range c.hybridApps {
+			steps = append(steps, teardownStep{teardownHybridApplication, bg.ID, c.env.ID, strconv.Itoa(app.ID), envPath + "/" + app.Artifact.Name})
+		}
+
+		steps = append(steps, teardownStep{teardownEnvironment, bg.ID, c.env.ID, c.env.ID, envPath})
+	}
+
+	return append(steps, teardownStep{teardownBusinessGroup, bg.ID, "", bg.ID, path}), nil
+}
+
+//listBGEnvironmentContents returns the environments of a business group with the applications deployed to them
+func listBGEnvironmentContents(client *sdk.AnypointClient) func(orgID string) ([]bgEnvironmentContents, error) {
+	return func(orgID string) ([]bgEnvironmentContents, error) {
+		envs, err := client.AccessManagement.ListEnvironments(orgID)
+		if err != nil {
+			return nil, err
+		}
+
+		contents := make([]bgEnvironmentContents, 0, len(envs))
+		for _, env := range envs {
+			deployments, err := client.ApplicationManager.ListDeployments(orgID, env.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			hybridApps, err := client.RuntimeManager.ListHybridApplications(orgID, env.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			cloudHubApps, err := client.CloudHub.ListApplications(orgID, env.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			contents = append(contents, bgEnvironmentContents{env, deployments, hybridApps, cloudHubApps})
+		}
+
+		return contents, nil
+	}
+}
+
+//runBGTeardown deletes, in order, everything listed by planBGTeardown. It stops at the first failure so that
+//nothing is deleted while what it depends on is still there
+func runBGTeardown(client *sdk.AnypointClient, steps []teardownStep) error {
+	for _, s := range steps {
+		log.Printf("Force destroy: deleting %s", s)
+
+		var err error
+		switch s.kind {
+		case teardownDeployment:
+			err = client.ApplicationManager.DeleteDeployment(s.orgID, s.envID, s.id)
+		case teardownCloudHubApp:
+			err = client.CloudHub.DeleteApplication(s.orgID, s.envID, s.id)
+		case teardownHybridApplication:
+			var appID int
+			if appID, err = strconv.Atoi(s.id); err == nil {
+				err = client.RuntimeManager.DeleteHybridApplication(s.orgID, s.envID, appID)
+			}
+		case teardownEnvironment:
+			err = client.AccessManagement.DeleteEnvironment(s.orgID, s.envID)
+		case teardownBusinessGroup:
+			err = client.AccessManagement.DeleteBusinessGroup(s.orgID)
+		}
+
+		if err != nil {
+			return fmt.Errorf("error while force destroying, could not delete %s : %s", s, err)
+		}
+	}
+
+	return nil
+}
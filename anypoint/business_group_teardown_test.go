@@ -0,0 +1,57 @@
+package anypoint
+
+import (
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"reflect"
+	"testing"
+)
+
+func TestPlanBGTeardown(t *testing.T) {
+	root := sdk.BusinessGroup{
+		ID:   "retail-id",
+		Name: "Retail",
+		SubOrganizations: []sdk.BusinessGroup{
+			{ID: "apis-id", Name: "APIs"},
+		},
+	}
+
+	contents := map[string][]bgEnvironmentContents{
+		"retail-id": {
+			{
+				env:          sdk.Environment{ID: "sandbox-id", Name: "Sandbox"},
+				deployments:  []sdk.Deployment{{ID: "deployment-id", Name: "orders"}},
+				hybridApps:   []sdk.HybridApplication{{ID: 42, Artifact: sdk.HybridArtifact{Name: "billing"}}},
+				cloudHubApps: []sdk.CloudHubApplication{{Domain: "retail-legacy"}},
+			},
+		},
+		"apis-id": {
+			{env: sdk.Environment{ID: "design-id", Name: "Design"}},
+		},
+	}
+
+	steps, err := planBGTeardown(root, "Retail", func(orgID string) ([]bgEnvironmentContents, error) {
+		return contents[orgID], nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error : %s", err)
+	}
+
+	report := []string{}
+	for _, step := range steps {
+		report = append(report, step.String())
+	}
+
+	expected := []string{
+		"environment Retail/APIs/Design (design-id)",
+		"business group Retail/APIs (apis-id)",
+		"deployment Retail/Sandbox/orders (deployment-id)",
+		"CloudHub application Retail/Sandbox/retail-legacy (retail-legacy)",
+		"hybrid application Retail/Sandbox/billing (42)",
+		"environment Retail/Sandbox (sandbox-id)",
+		"business group Retail (retail-id)",
+	}
+
+	if !reflect.DeepEqual(report, expected) {
+		t.Errorf("expected teardown\n%v\ngot\n%v", expected, report)
+	}
+}
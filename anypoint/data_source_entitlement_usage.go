@@ -0,0 +1,152 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+)
+
+func dataSourceEntitlementUsage() *schema.Resource {
+
+	return &schema.Resource{
+		Read: dataSourceEntitlementUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"root_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The business group the hierarchy is walked from, either a business group ID or a business group path such as Root/Retail",
+				Required:    true,
+			},
+			"assigned": &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Units assigned to the root business group, by entitlement",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+			"reassigned": &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Units the root business group reassigned to its sub organizations, by entitlement",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+			"available": &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Units the root business group can still assign to new sub organizations, by entitlement",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+			"descendants_assigned": &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Units assigned to the descendants of the root business group, summed, by entitlement",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+			"descendants_reassigned": &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Units the descendants of the root business group reassigned further down, summed, by entitlement",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+			"descendants_available": &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Units the descendants of the root business group kept for themselves, summed, by entitlement",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeFloat},
+			},
+			"groups": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The root business group followed by its descendants, depth first",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"assigned": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+						"reassigned": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+						"available": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeFloat},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+//dataSourceEntitlementUsageRead reports, for each business group under root_id and for the whole hierarchy,
+//how much of production_vcores, sandbox_vcores, design_vcores, vpcs, vpns, static_ips and load_balancers
+//is assigned, reassigned to sub organizations and still available
+func dataSourceEntitlementUsageRead(d *schema.ResourceData, conf interface{}) error {
+	client := conf.(*Config).AnypointClient
+	rootID := d.Get("root_id").(string)
+
+	if !uuidPattern.MatchString(rootID) {
+		id, err := findBusinessGroupByPath(client.AccessManagement, rootID)
+		if err != nil {
+			return fmt.Errorf("error while resolving business group %s : %s", rootID, err)
+		}
+		rootID = id
+	}
+
+	root, err := client.AccessManagement.GetBusinessGroupHierarchy(rootID)
+	if err != nil {
+		return err
+	}
+
+	own := groupEntitlementUsage(root)
+	descendants := rolledUpEntitlementUsage(root)
+
+	d.SetId(root.ID)
+	d.Set("assigned", own.assigned)
+	d.Set("reassigned", own.reassigned)
+	d.Set("available", own.available)
+	d.Set("descendants_assigned", descendants.assigned)
+	d.Set("descendants_reassigned", descendants.reassigned)
+	d.Set("descendants_available", descendants.available)
+
+	if err := d.Set("groups", flattenEntitlementUsage(root, root.ParentOrgId)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func flattenEntitlementUsage(bg sdk.BusinessGroup, parentID string) []interface{} {
+	usage := groupEntitlementUsage(bg)
+	groups := []interface{}{
+		map[string]interface{}{
+			"id":         bg.ID,
+			"name":       bg.Name,
+			"parent_id":  parentID,
+			"assigned":   usage.assigned,
+			"reassigned": usage.reassigned,
+			"available":  usage.available,
+		},
+	}
+
+	for _, sub := range bg.SubOrganizations {
+		groups = append(groups, flattenEntitlementUsage(sub, bg.ID)...)
+	}
+
+	return groups
+}
@@ -0,0 +1,42 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceEntitlementUsage_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckVars(t, "ANYPOINT_ORG_ID")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceEntitlementUsageConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.anypoint_entitlement_usage.test", "id", os.Getenv("ANYPOINT_ORG_ID")),
+					resource.TestCheckResourceAttr("data.anypoint_entitlement_usage.test", "groups.0.id", os.Getenv("ANYPOINT_ORG_ID")),
+					resource.TestCheckResourceAttrSet("data.anypoint_entitlement_usage.test", "available.production_vcores"),
+					resource.TestCheckResourceAttrSet("data.anypoint_entitlement_usage.test", "descendants_available.production_vcores"),
+					resource.TestCheckResourceAttrSet("data.anypoint_entitlement_usage.test", "groups.0.assigned.vpcs"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceEntitlementUsageConfig_basic() string {
+
+	return fmt.Sprintf(`
+		data "anypoint_entitlement_usage" "test" {
+			root_id = "%s"
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"))
+}
@@ -0,0 +1,176 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"regexp"
+	"strings"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func dataSourceExchangeAsset() *schema.Resource {
+
+	return &schema.Resource{
+		Read: dataSourceExchangeAssetRead,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The group of the asset, either a business group ID or a business group path such as Root/Retail",
+				Required:    true,
+			},
+			"asset_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version_constraint": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The versions acceptable. Example: ^1.2.0, ~1.2.0, 1.x, >=1.0.0 <2.0.0",
+				Optional:    true,
+				Default:     "*",
+			},
+			"resolved_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The highest version matching version_constraint",
+				Computed:    true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"files": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"classifier": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"packaging": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"main_file": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"download_url": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sha1": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"md5": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+//findBusinessGroupByPath returns the ID of the business group at path, in the form Root/Retail/APIs. A single
+//segment is rejected since FindBusinessGroup resolves it to the root organization whatever its name
+func findBusinessGroupByPath(auth *sdk.AccessManagement, path string) (string, error) {
+	if len(auth.CreateBusinessGroupPath(path)) < 2 {
+		return "", fmt.Errorf("%q is neither a business group ID nor a business group path such as Root/Retail", path)
+	}
+
+	id, err := auth.FindBusinessGroup(path)
+	if err != nil {
+		return "", err
+	}
+
+	if id == "" {
+		return "", fmt.Errorf("business group %s not found", path)
+	}
+
+	return id, nil
+}
+
+//dataSourceExchangeAssetRead resolves the version constraint against the published versions of the asset.
+//Deprecated versions are never selected
+func dataSourceExchangeAssetRead(d *schema.ResourceData, conf interface{}) error {
+	client := conf.(*Config).AnypointClient
+	groupID := d.Get("group_id").(string)
+	assetID := d.Get("asset_id").(string)
+
+	if !uuidPattern.MatchString(groupID) {
+		id, err := findBusinessGroupByPath(client.AccessManagement, groupID)
+		if err != nil {
+			return fmt.Errorf("error while resolving group %s : %s", groupID, err)
+		}
+		groupID = id
+	}
+
+	assets, err := client.Exchange.GetAssetVersions(groupID, assetID)
+	if err != nil {
+		return err
+	}
+
+	versions := []string{}
+	for _, asset := range assets {
+		if asset.Status != sdk.AssetStatusDeprecated {
+			versions = append(versions, asset.Version)
+		}
+	}
+
+	version, err := highestMatchingVersion(d.Get("version_constraint").(string), versions)
+	if err != nil {
+		return fmt.Errorf("error while resolving the version of asset %s:%s : %s", groupID, assetID, err)
+	}
+
+	asset, err := client.Exchange.GetAsset(groupID, assetID, version)
+	if err != nil {
+		return fmt.Errorf("error while reading asset %s:%s:%s : %s", groupID, assetID, version, err)
+	}
+
+	d.SetId(strings.Join([]string{groupID, assetID, version}, "/"))
+	d.Set("resolved_group_id", groupID)
+	d.Set("version", version)
+	d.Set("name", asset.Name)
+	d.Set("description", asset.Description)
+	d.Set("type", asset.Classifier)
+	d.Set("status", asset.Status)
+
+	files := make([]interface{}, 0, len(asset.Files))
+	for _, file := range asset.Files {
+		files = append(files, map[string]interface{}{
+			"classifier":   file.Classifier,
+			"packaging":    file.Packaging,
+			"main_file":    file.MainFile,
+			"download_url": file.DownloadURL,
+			"sha1":         file.SHA1,
+			"md5":          file.MD5,
+		})
+	}
+
+	if err := d.Set("files", files); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"os"
+	"testing"
+)
+
+func TestAccDataSourceExchangeAsset_constraint(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckVars(t, "ANYPOINT_ORG_ID", "ANYPOINT_API_ASSET_ID")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceExchangeAssetConfig_constraint("*"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.anypoint_exchange_asset.test", "resolved_group_id", os.Getenv("ANYPOINT_ORG_ID")),
+					resource.TestCheckResourceAttrSet("data.anypoint_exchange_asset.test", "version"),
+					resource.TestCheckResourceAttrSet("data.anypoint_exchange_asset.test", "files.0.download_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceExchangeAssetConfig_constraint(constraint string) string {
+
+	return fmt.Sprintf(`
+		data "anypoint_exchange_asset" "test" {
+			group_id           = "%s"
+			asset_id           = "%s"
+			version_constraint = "%s"
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_API_ASSET_ID"), constraint)
+}
@@ -0,0 +1,253 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+)
+
+//checkEntitlementAvailable fails when a business group cannot afford `requested` more units of an
+//entitlement on top of the `inUse` units its own resources already consume
+func checkEntitlementAvailable(bg sdk.BusinessGroup, name string, ent sdk.EntitlementStatus, inUse, requested float64) error {
+	if left := ent.Available() - inUse; left < requested {
+		return fmt.Errorf("business group %s (%s) does not have enough %s left: assigned %v, reassigned to sub organizations %v, in use %v, requested %v",
+			bg.Name, bg.ID, name, ent.Assigned, ent.Reassigned, inUse, requested)
+	}
+
+	return nil
+}
+
+//bgEntitlement ties an ap_bg attribute holding a quantity to its entitlement in sdk.Entitlements.
+//get returns the units assigned to a business group, capacity the units a business group can hand over
+//to its sub organizations (defaults to get)
+type bgEntitlement struct {
+	attribute string
+	get       func(sdk.Entitlements) float64
+	set       func(*sdk.Entitlements, float64)
+	capacity  func(sdk.Entitlements) float64
+}
+
+//bgFeature ties an ap_bg attribute to an entitlement that is either granted or not
+type bgFeature struct {
+	attribute string
+	get       func(sdk.Entitlements) bool
+	set       func(*sdk.Entitlements, bool)
+}
+
+var bgEntitlements = []bgEntitlement{
+	{
+		attribute: "production_vcores",
+		get:       func(e sdk.Entitlements) float64 { return e.ProductionVCores.Assigned },
+		set:       func(e *sdk.Entitlements, v float64) { e.ProductionVCores.Assigned = v },
+	},
+	{
+		attribute: "sandbox_vcores",
+		get:       func(e sdk.Entitlements) float64 { return e.SandboxVCores.Assigned },
+		set:       func(e *sdk.Entitlements, v float64) { e.SandboxVCores.Assigned = v },
+	},
+	{
+		attribute: "design_vcores",
+		get:       func(e sdk.Entitlements) float64 { return e.DesignVCores.Assigned },
+		set:       func(e *sdk.Entitlements, v float64) { e.DesignVCores.Assigned = v },
+	},
+	{
+		attribute: "static_ips",
+		get:       func(e sdk.Entitlements) float64 { return e.StaticIPs.Assigned },
+		set:       func(e *sdk.Entitlements, v float64) { e.StaticIPs.Assigned = v },
+	},
+	{
+		attribute: "vpcs",
+		get:       func(e sdk.Entitlements) float64 { return e.VPCs.Assigned },
+		set:       func(e *sdk.Entitlements, v float64) { e.VPCs.Assigned = v },
+	},
+	{
+		attribute: "load_balancers",
+		get:       func(e sdk.Entitlements) float64 { return e.LoadBalancer.Assigned },
+		set:       func(e *sdk.Entitlements, v float64) { e.LoadBalancer.Assigned = v },
+	},
+	{
+		attribute: "vpns",
+		get:       func(e sdk.Entitlements) float64 { return e.VPNs.Assigned },
+		set:       func(e *sdk.Entitlements, v float64) { e.VPNs.Assigned = v },
+	},
+	{
+		attribute: "mq_messages",
+		get:       func(e sdk.Entitlements) float64 { return quotaBase(e.MQMessages) },
+		set:       func(e *sdk.Entitlements, v float64) { e.MQMessages = withQuotaBase(e.MQMessages, v) },
+		capacity:  func(e sdk.Entitlements) float64 { return e.MQMessages.Total() },
+	},
+	{
+		attribute: "mq_requests",
+		get:       func(e sdk.Entitlements) float64 { return quotaBase(e.MQRequests) },
+		set:       func(e *sdk.Entitlements, v float64) { e.MQRequests = withQuotaBase(e.MQRequests, v) },
+		capacity:  func(e sdk.Entitlements) float64 { return e.MQRequests.Total() },
+	},
+	{
+		attribute: "object_store_request_units",
+		get:       func(e sdk.Entitlements) float64 { return quotaBase(e.ObjectStoreRequestUnits) },
+		set: func(e *sdk.Entitlements, v float64) {
+			e.ObjectStoreRequestUnits = withQuotaBase(e.ObjectStoreRequestUnits, v)
+		},
+		capacity: func(e sdk.Entitlements) float64 { return e.ObjectStoreRequestUnits.Total() },
+	},
+	{
+		attribute: "object_store_keys",
+		get:       func(e sdk.Entitlements) float64 { return quotaBase(e.ObjectStoreKeys) },
+		set:       func(e *sdk.Entitlements, v float64) { e.ObjectStoreKeys = withQuotaBase(e.ObjectStoreKeys, v) },
+		capacity:  func(e sdk.Entitlements) float64 { return e.ObjectStoreKeys.Total() },
+	},
+}
+
+var bgFeatures = []bgFeature{
+	{
+		attribute: "can_create_sub_orgs",
+		get:       func(e sdk.Entitlements) bool { return e.CreateSubOrgs },
+		set:       func(e *sdk.Entitlements, v bool) { e.CreateSubOrgs = v },
+	},
+	{
+		attribute: "can_create_environments",
+		get:       func(e sdk.Entitlements) bool { return e.CreateEnvironments },
+		set:       func(e *sdk.Entitlements, v bool) { e.CreateEnvironments = v },
+	},
+	{
+		attribute: "global_deployment",
+		get:       func(e sdk.Entitlements) bool { return e.GlobalDeployment },
+		set:       func(e *sdk.Entitlements, v bool) { e.GlobalDeployment = v },
+	},
+	{
+		attribute: "runtime_fabric",
+		get:       func(e sdk.Entitlements) bool { return e.RuntimeFabric != nil && *e.RuntimeFabric },
+		set:       func(e *sdk.Entitlements, v bool) { e.RuntimeFabric = &v },
+	},
+	{
+		attribute: "api_manager",
+		get:       func(e sdk.Entitlements) bool { return e.APIManager != nil && e.APIManager.Enabled },
+		set:       func(e *sdk.Entitlements, v bool) { e.APIManager = &sdk.EntitlementEnabled{Enabled: v} },
+	},
+}
+
+func quotaBase(q *sdk.EntitlementQuota) float64 {
+	if q == nil {
+		return 0
+	}
+
+	return q.Base
+}
+
+//withQuotaBase returns a copy of q with its base allowance set to base, keeping its add-ons
+func withQuotaBase(q *sdk.EntitlementQuota, base float64) *sdk.EntitlementQuota {
+	quota := sdk.EntitlementQuota{Base: base}
+	if q != nil {
+		quota.AddOn = q.AddOn
+	}
+
+	return &quota
+}
+
+//parentCapacity returns what the parent business group can hand over for the entitlement, and how much of
+//it is already assigned to sub organizations other than bgID
+func (e bgEntitlement) parentCapacity(parent sdk.BusinessGroup, bgID string) sdk.EntitlementStatus {
+	capacity := e.get
+	if e.capacity != nil {
+		capacity = e.capacity
+	}
+
+	status := sdk.EntitlementStatus{Assigned: capacity(parent.Entitlements)}
+	for _, sub := range parent.SubOrganizations {
+		if sub.ID != bgID {
+			status.Reassigned += e.get(sub.Entitlements)
+		}
+	}
+
+	return status
+}
+
+//checkBGEntitlements fails when the parent business group cannot grant the requested entitlements to its
+//sub organization bgID (empty when the business group is yet to be created). parent must come with its
+//sub organizations, as returned by GetBusinessGroupHierarchy. Only the attributes for which changed
+//returns true are checked, so that a parent already over-allocated does not block unrelated changes
+func checkBGEntitlements(parent sdk.BusinessGroup, bgID string, requested sdk.Entitlements, changed func(attribute string) bool) error {
+	for _, f := range bgFeatures {
+		if changed(f.attribute) && f.get(requested) && !f.get(parent.Entitlements) {
+			return fmt.Errorf("business group %s (%s) cannot grant %s to its sub organizations as it does not have it itself",
+				parent.Name, parent.ID, f.attribute)
+		}
+	}
+
+	for _, e := range bgEntitlements {
+		if value := e.get(requested); changed(e.attribute) && value > 0 {
+			if err := checkEntitlementAvailable(parent, e.attribute, e.parentCapacity(parent, bgID), 0, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+//usageEntitlements lists the entitlements a business group can reassign to its sub organizations, as
+//reported by anypoint_entitlement_usage
+var usageEntitlements = []struct {
+	attribute string
+	status    func(sdk.Entitlements) sdk.EntitlementStatus
+}{
+	{"production_vcores", func(e sdk.Entitlements) sdk.EntitlementStatus { return e.ProductionVCores }},
+	{"sandbox_vcores", func(e sdk.Entitlements) sdk.EntitlementStatus { return e.SandboxVCores }},
+	{"design_vcores", func(e sdk.Entitlements) sdk.EntitlementStatus { return e.DesignVCores }},
+	{"vpcs", func(e sdk.Entitlements) sdk.EntitlementStatus { return e.VPCs }},
+	{"vpns", func(e sdk.Entitlements) sdk.EntitlementStatus { return e.VPNs }},
+	{"static_ips", func(e sdk.Entitlements) sdk.EntitlementStatus { return e.StaticIPs }},
+	{"load_balancers", func(e sdk.Entitlements) sdk.EntitlementStatus { return e.LoadBalancer }},
+}
+
+//entitlementUsage is the assigned, reassigned and available units of each of usageEntitlements
+type entitlementUsage struct {
+	assigned   map[string]float64
+	reassigned map[string]float64
+	available  map[string]float64
+}
+
+func newEntitlementUsage() entitlementUsage {
+	return entitlementUsage{map[string]float64{}, map[string]float64{}, map[string]float64{}}
+}
+
+//groupEntitlementUsage returns the usage of a single business group
+func groupEntitlementUsage(bg sdk.BusinessGroup) entitlementUsage {
+	usage := newEntitlementUsage()
+	for _, u := range usageEntitlements {
+		status := u.status(bg.Entitlements)
+		usage.assigned[u.attribute] = status.Assigned
+		usage.reassigned[u.attribute] = status.Reassigned
+		usage.available[u.attribute] = status.Available()
+	}
+
+	return usage
+}
+
+//rolledUpEntitlementUsage returns the usage of all the descendants of root together: the units they were
+//assigned, the units they reassigned further down, and the difference, which they keep for themselves
+func rolledUpEntitlementUsage(root sdk.BusinessGroup) entitlementUsage {
+	usage := newEntitlementUsage()
+	for _, u := range usageEntitlements {
+		usage.assigned[u.attribute] = 0
+		usage.reassigned[u.attribute] = 0
+	}
+
+	var walk func(groups []sdk.BusinessGroup)
+	walk = func(groups []sdk.BusinessGroup) {
+		for _, bg := range groups {
+			for _, u := range usageEntitlements {
+				status := u.status(bg.Entitlements)
+				usage.assigned[u.attribute] += status.Assigned
+				usage.reassigned[u.attribute] += status.Reassigned
+			}
+			walk(bg.SubOrganizations)
+		}
+	}
+	walk(root.SubOrganizations)
+
+	for _, u := range usageEntitlements {
+		usage.available[u.attribute] = usage.assigned[u.attribute] - usage.reassigned[u.attribute]
+	}
+
+	return usage
+}
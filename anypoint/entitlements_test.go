@@ -0,0 +1,127 @@
+package anypoint
+
+import (
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"testing"
+)
+
+func TestCheckEntitlementAvailable(t *testing.T) {
+	bg := sdk.BusinessGroup{ID: "bg-id", Name: "Retail"}
+
+	cases := []struct {
+		ent       sdk.EntitlementStatus
+		inUse     float64
+		requested float64
+		fails     bool
+	}{
+		{sdk.EntitlementStatus{Assigned: 2}, 0, 1, false},
+		{sdk.EntitlementStatus{Assigned: 2}, 1, 1, false},
+		{sdk.EntitlementStatus{Assigned: 2}, 2, 1, true},
+		{sdk.EntitlementStatus{Assigned: 2, Reassigned: 1}, 1, 1, true},
+		{sdk.EntitlementStatus{Assigned: 1.5, Reassigned: 0.5}, 0, 1, false},
+		{sdk.EntitlementStatus{}, 0, 1, true},
+	}
+
+	for i, c := range cases {
+		err := checkEntitlementAvailable(bg, "vpcs", c.ent, c.inUse, c.requested)
+
+		if c.fails && err == nil {
+			t.Errorf("case %d: expected an error for %+v with %v in use and %v requested", i, c.ent, c.inUse, c.requested)
+		}
+
+		if !c.fails && err != nil {
+			t.Errorf("case %d: unexpected error : %s", i, err)
+		}
+	}
+}
+
+func TestCheckBGEntitlements(t *testing.T) {
+	enabled := true
+	parent := sdk.BusinessGroup{
+		ID:   "parent-id",
+		Name: "Retail",
+		Entitlements: sdk.Entitlements{
+			ProductionVCores: sdk.EntitlementStatus{Assigned: 4, Reassigned: 3},
+			MQMessages:       &sdk.EntitlementQuota{Base: 10, AddOn: 5},
+			RuntimeFabric:    &enabled,
+		},
+		SubOrganizations: []sdk.BusinessGroup{
+			{ID: "bg-id", Entitlements: sdk.Entitlements{ProductionVCores: sdk.EntitlementStatus{Assigned: 1}}},
+			{ID: "sibling-id", Entitlements: sdk.Entitlements{
+				ProductionVCores: sdk.EntitlementStatus{Assigned: 2},
+				MQMessages:       &sdk.EntitlementQuota{Base: 10},
+			}},
+		},
+	}
+	all := func(string) bool { return true }
+
+	cases := []struct {
+		bgID      string
+		requested sdk.Entitlements
+		changed   func(string) bool
+		fails     bool
+	}{
+		{"bg-id", sdk.Entitlements{ProductionVCores: sdk.EntitlementStatus{Assigned: 2}}, all, false},
+		{"bg-id", sdk.Entitlements{ProductionVCores: sdk.EntitlementStatus{Assigned: 3}}, all, true},
+		{"", sdk.Entitlements{ProductionVCores: sdk.EntitlementStatus{Assigned: 2}}, all, true},
+		{"", sdk.Entitlements{ProductionVCores: sdk.EntitlementStatus{Assigned: 1}}, all, false},
+		{"", sdk.Entitlements{MQMessages: &sdk.EntitlementQuota{Base: 5}}, all, false},
+		{"", sdk.Entitlements{MQMessages: &sdk.EntitlementQuota{Base: 6}}, all, true},
+		{"", sdk.Entitlements{RuntimeFabric: &enabled}, all, false},
+		{"", sdk.Entitlements{APIManager: &sdk.EntitlementEnabled{Enabled: true}}, all, true},
+		{"", sdk.Entitlements{GlobalDeployment: true}, all, true},
+		{"bg-id", sdk.Entitlements{ProductionVCores: sdk.EntitlementStatus{Assigned: 3}}, func(a string) bool { return a != "production_vcores" }, false},
+	}
+
+	for i, c := range cases {
+		err := checkBGEntitlements(parent, c.bgID, c.requested, c.changed)
+
+		if c.fails && err == nil {
+			t.Errorf("case %d: expected an error for %+v", i, c.requested)
+		}
+
+		if !c.fails && err != nil {
+			t.Errorf("case %d: unexpected error : %s", i, err)
+		}
+	}
+}
+
+func TestRolledUpEntitlementUsage(t *testing.T) {
+	root := sdk.BusinessGroup{
+		ID:           "root-id",
+		Entitlements: sdk.Entitlements{VPCs: sdk.EntitlementStatus{Assigned: 10, Reassigned: 6}},
+		SubOrganizations: []sdk.BusinessGroup{
+			{
+				ID:           "retail-id",
+				Entitlements: sdk.Entitlements{VPCs: sdk.EntitlementStatus{Assigned: 4, Reassigned: 3}},
+				SubOrganizations: []sdk.BusinessGroup{
+					{ID: "apis-id", Entitlements: sdk.Entitlements{VPCs: sdk.EntitlementStatus{Assigned: 3}}},
+				},
+			},
+			{ID: "finance-id", Entitlements: sdk.Entitlements{VPCs: sdk.EntitlementStatus{Assigned: 2}}},
+		},
+	}
+
+	usage := rolledUpEntitlementUsage(root)
+
+	if usage.assigned["vpcs"] != 9 || usage.reassigned["vpcs"] != 3 {
+		t.Errorf("expected 9 vpcs assigned and 3 reassigned below the root, got %v and %v", usage.assigned["vpcs"], usage.reassigned["vpcs"])
+	}
+
+	if usage.available["vpcs"] != 6 {
+		t.Errorf("expected 6 vpcs kept below the root, got %v", usage.available["vpcs"])
+	}
+
+	if usage.available["vpns"] != 0 {
+		t.Errorf("expected no vpns available, got %v", usage.available["vpns"])
+	}
+
+	groups := flattenEntitlementUsage(root, "")
+	if len(groups) != 4 {
+		t.Fatalf("expected 4 groups, got %d", len(groups))
+	}
+
+	if apis := groups[2].(map[string]interface{}); apis["id"] != "apis-id" || apis["parent_id"] != "retail-id" {
+		t.Errorf("expected apis-id under retail-id as third group, got %v under %v", apis["id"], apis["parent_id"])
+	}
+}
@@ -0,0 +1,113 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"strconv"
+	"strings"
+)
+
+//importOrgScopedResource imports resources whose API path only depends on the business group.
+//The import ID has the format <org_id>/<id>
+func importOrgScopedResource(d *schema.ResourceData, conf interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid import ID %q. Expected format is <org_id>/<id>", d.Id())
+	}
+
+	d.Set("org_id", parts[0])
+	d.SetId(parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//parseEnvScopedImportID splits import IDs of the form [<org_id>/]<env_id>/<id>... where `ids` is the number
+//of trailing IDs the resource needs. When the org is omitted, the root business group of the user is used
+func parseEnvScopedImportID(importID string, conf interface{}, ids int) (orgID, envID string, rest []string, err error) {
+	parts := strings.Split(importID, "/")
+
+	for _, part := range parts {
+		if part == "" {
+			return "", "", nil, fmt.Errorf("invalid import ID %q: empty segment", importID)
+		}
+	}
+
+	switch len(parts) {
+	case ids + 1:
+		orgID = conf.(*Config).AnypointClient.AccessManagement.Hierarchy().ID
+		return orgID, parts[0], parts[1:], nil
+	case ids + 2:
+		return parts[0], parts[1], parts[2:], nil
+	}
+
+	return "", "", nil, fmt.Errorf("invalid import ID %q. Expected %d IDs after [<org_id>/]<env_id>", importID, ids)
+}
+
+//importEnvScopedResource imports resources identified by an environment and their own ID.
+//The import ID has the format [<org_id>/]<env_id>/<id>
+func importEnvScopedResource(d *schema.ResourceData, conf interface{}) ([]*schema.ResourceData, error) {
+	orgID, envID, ids, err := parseEnvScopedImportID(d.Id(), conf, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("org_id", orgID)
+	d.Set("env_id", envID)
+	d.SetId(ids[0])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//importAPIScopedResource imports resources attached to an API instance, such as policies and SLA tiers.
+//The import ID has the format [<org_id>/]<env_id>/<api_id>/<id>
+func importAPIScopedResource(d *schema.ResourceData, conf interface{}) ([]*schema.ResourceData, error) {
+	orgID, envID, ids, err := parseEnvScopedImportID(d.Id(), conf, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	apiID, err := strconv.Atoi(ids[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid API instance ID %q : %s", ids[0], err)
+	}
+
+	d.Set("org_id", orgID)
+	d.Set("env_id", envID)
+	d.Set("api_id", apiID)
+	d.SetId(ids[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//importRegionScopedResource imports resources living in a region of an environment, such as MQ destinations.
+//The import ID has the format [<org_id>/]<env_id>/<region_id>/<id>
+func importRegionScopedResource(d *schema.ResourceData, conf interface{}) ([]*schema.ResourceData, error) {
+	orgID, envID, ids, err := parseEnvScopedImportID(d.Id(), conf, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("org_id", orgID)
+	d.Set("env_id", envID)
+	d.Set("region_id", ids[0])
+	d.SetId(ids[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//importSecretGroupScopedResource imports the secrets held by a secret group, such as keystores.
+//The import ID has the format [<org_id>/]<env_id>/<secret_group_id>/<id>
+func importSecretGroupScopedResource(d *schema.ResourceData, conf interface{}) ([]*schema.ResourceData, error) {
+	orgID, envID, ids, err := parseEnvScopedImportID(d.Id(), conf, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("org_id", orgID)
+	d.Set("env_id", envID)
+	d.Set("secret_group_id", ids[0])
+	d.SetId(ids[1])
+
+	return []*schema.ResourceData{d}, nil
+}
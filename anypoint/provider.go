@@ -40,8 +40,43 @@ func Provider() *schema.Provider {
 			},
 		},
 		ConfigureFunc: providerConfigure,
+		DataSourcesMap: map[string]*schema.Resource{
+			"anypoint_exchange_asset":    dataSourceExchangeAsset(),
+			"anypoint_entitlement_usage": dataSourceEntitlementUsage(),
+		},
 		ResourcesMap: map[string]*schema.Resource{
-			"ap_bg": resourceBusinessGroup(),
+			"ap_bg":                                 resourceBusinessGroup(),
+			"anypoint_hybrid_application":           resourceHybridApplication(),
+			"anypoint_app_deployment":               resourceAppDeployment(),
+			"anypoint_private_space":                resourcePrivateSpace(),
+			"anypoint_private_space_network":        resourcePrivateSpaceNetwork(),
+			"anypoint_private_space_firewall_rules": resourcePrivateSpaceFirewallRules(),
+			"anypoint_vpc":                          resourceVPC(),
+			"anypoint_dedicated_load_balancer":      resourceDedicatedLoadBalancer(),
+			"anypoint_vpn":                          resourceVPN(),
+			"anypoint_static_ip":                    resourceStaticIP(),
+			"anypoint_api_instance":                 resourceAPIInstance(),
+			"anypoint_api_policy":                   resourceAPIPolicy(),
+			"anypoint_api_sla_tier":                 resourceAPISLATier(),
+			"anypoint_client_application":           resourceClientApplication(),
+			"anypoint_api_contract":                 resourceAPIContract(),
+			"anypoint_exchange_asset":               resourceExchangeAsset(),
+			"anypoint_mq_queue":                     resourceMQQueue(),
+			"anypoint_mq_exchange":                  resourceMQExchange(),
+			"anypoint_mq_exchange_binding":          resourceMQExchangeBinding(),
+			"anypoint_mq_client":                    resourceMQClient(),
+			"anypoint_object_store":                 resourceObjectStore(),
+			"anypoint_object_store_key":             resourceObjectStoreKey(),
+			"anypoint_secret_group":                 resourceSecretGroup(),
+			"anypoint_keystore":                     resourceKeystore(),
+			"anypoint_truststore":                   resourceTruststore(),
+			"anypoint_certificate":                  resourceCertificate(),
+			"anypoint_tls_context":                  resourceTLSContext(),
+			"anypoint_runtime_fabric":               resourceRuntimeFabric(),
+			"anypoint_runtime_fabric_association":   resourceRuntimeFabricAssociation(),
+			"anypoint_flex_gateway":                 resourceFlexGateway(),
+			"anypoint_runtime_alert":                resourceRuntimeAlert(),
+			"anypoint_api_alert":                    resourceAPIAlert(),
 		},
 	}
 }
@@ -18,16 +18,19 @@ func init() {
 
 	testAccProvider = Provider()
 	testAccProviders = map[string]terraform.ResourceProvider{
-		"ap": testAccProvider,
+		"ap":       testAccProvider,
+		"anypoint": testAccProvider,
 	}
 
 	testAccProviderFactories = func(providers *[]*schema.Provider) map[string]terraform.ResourceProviderFactory {
+		factory := func() (terraform.ResourceProvider, error) {
+			p := Provider()
+			*providers = append(*providers, p)
+			return p, nil
+		}
 		return map[string]terraform.ResourceProviderFactory{
-			"ap": func() (terraform.ResourceProvider, error) {
-				p := Provider()
-				*providers = append(*providers, p)
-				return p, nil
-			},
+			"ap":       factory,
+			"anypoint": factory,
 		}
 	}
 }
@@ -59,6 +62,28 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+//testAccPreCheckEnvironment checks the variables needed by resources scoped to an org and an environment
+func testAccPreCheckEnvironment(t *testing.T) {
+	testAccPreCheck(t)
+
+	if v := os.Getenv("ANYPOINT_ORG_ID"); v == "" {
+		t.Fatalf("ANYPOINT_ORG_ID must be set for acceptance tests")
+	}
+
+	if v := os.Getenv("ANYPOINT_ENV_ID"); v == "" {
+		t.Fatalf("ANYPOINT_ENV_ID must be set for acceptance tests")
+	}
+}
+
+//testAccPreCheckVars checks the extra variables a single acceptance test depends on
+func testAccPreCheckVars(t *testing.T, names ...string) {
+	for _, name := range names {
+		if v := os.Getenv(name); v == "" {
+			t.Fatalf("%s must be set for acceptance tests", name)
+		}
+	}
+}
+
 func testAccCheckWithProviders(f func(*terraform.State, *schema.Provider) error, providers *[]*schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		numberOfProviders := len(*providers)
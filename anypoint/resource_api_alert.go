@@ -0,0 +1,251 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceAPIAlert() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceAPIAlertCreate,
+		Read:          resourceAPIAlertRead,
+		Update:        resourceAPIAlertUpdate,
+		Delete:        resourceAPIAlertDelete,
+		CustomizeDiff: resourceAPIAlertCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importAPIScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the API instance the alert watches",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "api-response-code, api-response-time or api-policy-violation",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{sdk.APIAlertTypeResponseCode, sdk.APIAlertTypeResponseTime,
+					sdk.APIAlertTypePolicyViolation}, false),
+			},
+			"severity": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sdk.AlertSeverityWarning,
+				ValidateFunc: validation.StringInSlice([]string{sdk.AlertSeverityCritical, sdk.AlertSeverityWarning, sdk.AlertSeverityInfo}, false),
+			},
+			"operator": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ABOVE",
+				ValidateFunc: validation.StringInSlice([]string{"ABOVE", "BELOW"}, false),
+			},
+			"threshold": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "A number of requests for response codes and policy violations, milliseconds for response times",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"response_codes": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "The response codes counted. Only for api-response-code. Example: [\"500\", \"503\"]",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"policy_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The policy whose violations are counted. Only for api-policy-violation",
+				Optional:    true,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "The length of a period, in minutes",
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"repeat_count": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "How many consecutive periods the condition must be met before alerting",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"recipient_user_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"emails": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAPIAlertCreate(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	alert, err := apim.CreateAPIAlert(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), getAPIAlertFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(alert.ID)
+
+	return resourceAPIAlertRead(d, conf)
+}
+
+func resourceAPIAlertRead(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	alert, err := apim.GetAPIAlert(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("API alert %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading API alert %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", alert.Name)
+	d.Set("type", alert.Type)
+	d.Set("severity", alert.Severity)
+	d.Set("operator", alert.Condition.Operator)
+	d.Set("threshold", alert.Condition.Threshold)
+	d.Set("policy_id", alert.Condition.PolicyID)
+	d.Set("period", alert.Period)
+	d.Set("repeat_count", alert.RepeatCount)
+	d.Set("enabled", alert.Enabled)
+
+	if err := d.Set("response_codes", alert.Condition.ResponseCodes); err != nil {
+		return err
+	}
+
+	users := []string{}
+	emails := []string{}
+	for _, recipient := range alert.Recipients {
+		if recipient.Type == sdk.APIAlertRecipientUser {
+			users = append(users, recipient.Value)
+		} else {
+			emails = append(emails, recipient.Value)
+		}
+	}
+
+	if err := d.Set("recipient_user_ids", users); err != nil {
+		return err
+	}
+
+	if err := d.Set("emails", emails); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAPIAlertUpdate(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	alert := getAPIAlertFromData(d)
+	alert.ID = d.Id()
+
+	if _, err := apim.UpdateAPIAlert(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), alert); err != nil {
+		return err
+	}
+
+	return resourceAPIAlertRead(d, conf)
+}
+
+func resourceAPIAlertDelete(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	return apim.DeleteAPIAlert(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), d.Id())
+}
+
+//resourceAPIAlertCustomizeDiff checks that the condition attributes match the type of the alert and that
+//someone is notified
+func resourceAPIAlertCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	alertType := d.Get("type").(string)
+	hasCodes := d.Get("response_codes").(*schema.Set).Len() > 0
+	_, hasPolicy := d.GetOk("policy_id")
+
+	if d.NewValueKnown("type") && d.NewValueKnown("response_codes") && d.NewValueKnown("policy_id") {
+		if (alertType == sdk.APIAlertTypeResponseCode) != hasCodes {
+			return fmt.Errorf("response_codes must be set for %s alerts only", sdk.APIAlertTypeResponseCode)
+		}
+
+		if (alertType == sdk.APIAlertTypePolicyViolation) != hasPolicy {
+			return fmt.Errorf("policy_id must be set for %s alerts only", sdk.APIAlertTypePolicyViolation)
+		}
+	}
+
+	if !d.NewValueKnown("recipient_user_ids") || !d.NewValueKnown("emails") {
+		return nil
+	}
+
+	if d.Get("recipient_user_ids").(*schema.Set).Len()+d.Get("emails").(*schema.Set).Len() == 0 {
+		return fmt.Errorf("at least one of recipient_user_ids or emails must be set")
+	}
+
+	return nil
+}
+
+func getAPIAlertFromData(d *schema.ResourceData) sdk.APIAlert {
+	alert := sdk.APIAlert{
+		Name:        d.Get("name").(string),
+		Type:        d.Get("type").(string),
+		Severity:    d.Get("severity").(string),
+		Enabled:     d.Get("enabled").(bool),
+		Period:      d.Get("period").(int),
+		RepeatCount: d.Get("repeat_count").(int),
+		Condition: sdk.APIAlertCondition{
+			Operator:      d.Get("operator").(string),
+			Threshold:     d.Get("threshold").(int),
+			ResponseCodes: expandStringList(d.Get("response_codes").(*schema.Set).List()),
+			PolicyID:      d.Get("policy_id").(int),
+		},
+		Recipients: []sdk.APIAlertRecipient{},
+	}
+
+	for _, user := range d.Get("recipient_user_ids").(*schema.Set).List() {
+		alert.Recipients = append(alert.Recipients, sdk.APIAlertRecipient{Type: sdk.APIAlertRecipientUser, Value: user.(string)})
+	}
+
+	for _, email := range d.Get("emails").(*schema.Set).List() {
+		alert.Recipients = append(alert.Recipients, sdk.APIAlertRecipient{Type: sdk.APIAlertRecipientEmail, Value: email.(string)})
+	}
+
+	return alert
+}
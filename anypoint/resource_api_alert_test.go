@@ -0,0 +1,117 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestAccAPIAlert_responseCode(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+			testAccPreCheckVars(t, "ANYPOINT_API_ASSET_ID", "ANYPOINT_API_ASSET_VERSION")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAPIAlertDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAPIAlertConfig_responseCode(10, `policy_id = 1`),
+				ExpectError: regexp.MustCompile("policy_id must be set for api-policy-violation alerts only"),
+			},
+			{
+				Config: testAccAPIAlertConfig_responseCode(10, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_api_alert.test", "threshold", "10"),
+					resource.TestCheckResourceAttr("anypoint_api_alert.test", "response_codes.#", "2"),
+					resource.TestCheckResourceAttr("anypoint_api_alert.test", "emails.#", "1"),
+				),
+			},
+			{
+				Config: testAccAPIAlertConfig_responseCode(50, `enabled = false`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_api_alert.test", "threshold", "50"),
+					resource.TestCheckResourceAttr("anypoint_api_alert.test", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_api_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_api_alert.test"]
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.Attributes["api_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAPIAlertDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	apim := provider.Meta().(*Config).AnypointClient.APIManager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_api_alert" {
+			continue
+		}
+
+		apiID, err := strconv.Atoi(rs.Primary.Attributes["api_id"])
+		if err != nil {
+			return err
+		}
+
+		_, err = apim.GetAPIAlert(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], apiID, rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found API alert with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAPIAlertConfig_responseCode(threshold int, extra string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_api_instance" "test" {
+			org_id          = "%s"
+			env_id          = "%s"
+			asset_id        = "%s"
+			asset_version   = "%s"
+			deployment_type = "CH"
+
+			endpoint {
+				mode = "mule4"
+				uri  = "http://example.com/api"
+			}
+		}
+
+		resource "anypoint_api_alert" "test" {
+			org_id         = "${anypoint_api_instance.test.org_id}"
+			env_id         = "${anypoint_api_instance.test.env_id}"
+			api_id         = "${anypoint_api_instance.test.id}"
+			name           = "Server errors"
+			type           = "api-response-code"
+			severity       = "CRITICAL"
+			threshold      = %d
+			response_codes = ["500", "503"]
+			period         = 10
+			repeat_count   = 2
+			emails         = ["api-team@example.com"]
+			%s
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), os.Getenv("ANYPOINT_API_ASSET_ID"),
+		os.Getenv("ANYPOINT_API_ASSET_VERSION"), threshold, extra)
+}
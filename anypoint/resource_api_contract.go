@@ -0,0 +1,210 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"strconv"
+)
+
+func resourceAPIContract() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceAPIContractCreate,
+		Read:   resourceAPIContractRead,
+		Update: resourceAPIContractUpdate,
+		Delete: resourceAPIContractDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAPIScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the API instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"application_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the client application requesting access",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"application_org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the client application. Defaults to org_id",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"tier_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The SLA tier requested. Required when the API instance has SLA tiers",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"auto_approve": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Approve the contract as the API owner when the tier requires manual approval",
+				Optional:    true,
+				Default:     false,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAPIContractCreate(d *schema.ResourceData, conf interface{}) error {
+	client := conf.(*Config).AnypointClient
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+	apiID := d.Get("api_id").(int)
+
+	appOrgID := orgID
+	if val, isSet := d.GetOk("application_org_id"); isSet {
+		appOrgID = val.(string)
+	}
+
+	api, err := client.APIManager.GetAPIInstance(orgID, envID, apiID)
+	if err != nil {
+		return fmt.Errorf("error while reading API instance %d : %s", apiID, err)
+	}
+
+	contract, err := client.Exchange.RequestContract(appOrgID, d.Get("application_id").(int), sdk.Contract{
+		APIID:           apiID,
+		EnvironmentID:   envID,
+		OrganizationID:  orgID,
+		InstanceType:    "api",
+		GroupID:         api.GroupID,
+		AssetID:         api.AssetID,
+		Version:         api.AssetVersion,
+		RequestedTierID: d.Get("tier_id").(int),
+		AcceptedTerms:   true,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(contract.ID))
+	d.Set("application_org_id", appOrgID)
+
+	if d.Get("auto_approve").(bool) && contract.Status == sdk.ContractStatusPending {
+		if _, err := client.APIManager.ApproveContract(orgID, envID, apiID, contract.ID); err != nil {
+			return err
+		}
+	}
+
+	return resourceAPIContractRead(d, conf)
+}
+
+func resourceAPIContractRead(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	contractID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid contract ID %q : %s", d.Id(), err)
+	}
+
+	contract, err := apim.GetContract(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), contractID)
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Contract %d not found. Removing it from the state", contractID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading contract %d : %s", contractID, err)
+	}
+
+	//A revoked contract no longer grants access: recreate it
+	if contract.Status == sdk.ContractStatusRevoked {
+		log.Printf("Contract %d has been revoked. Removing it from the state", contractID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("application_id", contract.ApplicationID)
+	d.Set("status", contract.Status)
+
+	//Until the contract is approved only the requested tier is known
+	if contract.TierID != 0 {
+		d.Set("tier_id", contract.TierID)
+	} else {
+		d.Set("tier_id", contract.RequestedTierID)
+	}
+
+	return nil
+}
+
+//resourceAPIContractUpdate approves pending contracts once auto_approve is set
+func resourceAPIContractUpdate(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	contractID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid contract ID %q : %s", d.Id(), err)
+	}
+
+	if d.Get("auto_approve").(bool) && d.Get("status").(string) == sdk.ContractStatusPending {
+		if _, err := apim.ApproveContract(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), contractID); err != nil {
+			return err
+		}
+	}
+
+	return resourceAPIContractRead(d, conf)
+}
+
+//resourceAPIContractDelete revokes the contract, so that the application loses access right away, or rejects it
+//when it is still pending, and deletes it. The status is read again as the one in the state may be stale
+func resourceAPIContractDelete(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+	apiID := d.Get("api_id").(int)
+
+	contractID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid contract ID %q : %s", d.Id(), err)
+	}
+
+	contract, err := apim.GetContract(orgID, envID, apiID, contractID)
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Contract %d already deleted", contractID)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading contract %d : %s", contractID, err)
+	}
+
+	switch contract.Status {
+	case sdk.ContractStatusPending:
+		_, err = apim.RejectContract(orgID, envID, apiID, contractID)
+	case sdk.ContractStatusApproved:
+		_, err = apim.RevokeContract(orgID, envID, apiID, contractID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return apim.DeleteContract(orgID, envID, apiID, contractID)
+}
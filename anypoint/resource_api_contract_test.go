@@ -0,0 +1,129 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestAccAPIContract_approve(t *testing.T) {
+	var providers []*schema.Provider
+
+	appName := fmt.Sprintf("test-app-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+			testAccPreCheckVars(t, "ANYPOINT_API_ASSET_ID", "ANYPOINT_API_ASSET_VERSION")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAPIContractDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIContractConfig_approve(appName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_api_contract.test", "status", sdk.ContractStatusPending),
+				),
+			},
+			{
+				Config: testAccAPIContractConfig_approve(appName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_api_contract.test", "status", sdk.ContractStatusApproved),
+				),
+			},
+			{
+				ResourceName:            "anypoint_api_contract.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"application_org_id", "auto_approve"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_api_contract.test"]
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.Attributes["api_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAPIContractDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	apim := provider.Meta().(*Config).AnypointClient.APIManager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_api_contract" {
+			continue
+		}
+
+		apiID, err := strconv.Atoi(rs.Primary.Attributes["api_id"])
+		if err != nil {
+			return err
+		}
+
+		contractID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = apim.GetContract(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], apiID, contractID)
+
+		if err == nil {
+			return fmt.Errorf("Found contract with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAPIContractConfig_approve(appName string, autoApprove bool) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_api_instance" "test" {
+			org_id          = "%s"
+			env_id          = "%s"
+			asset_id        = "%s"
+			asset_version   = "%s"
+			deployment_type = "CH"
+
+			endpoint {
+				mode = "mule4"
+				uri  = "http://example.com/api"
+			}
+		}
+
+		resource "anypoint_api_sla_tier" "test" {
+			org_id = "${anypoint_api_instance.test.org_id}"
+			env_id = "${anypoint_api_instance.test.env_id}"
+			api_id = "${anypoint_api_instance.test.id}"
+			name   = "Silver"
+
+			limit {
+				maximum_requests            = 10
+				time_period_in_milliseconds = 1000
+			}
+		}
+
+		resource "anypoint_client_application" "test" {
+			org_id = "${anypoint_api_instance.test.org_id}"
+			name   = "%s"
+		}
+
+		resource "anypoint_api_contract" "test" {
+			org_id         = "${anypoint_api_sla_tier.test.org_id}"
+			env_id         = "${anypoint_api_sla_tier.test.env_id}"
+			api_id         = "${anypoint_api_sla_tier.test.api_id}"
+			tier_id        = "${anypoint_api_sla_tier.test.id}"
+			application_id = "${anypoint_client_application.test.id}"
+			auto_approve   = %t
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), os.Getenv("ANYPOINT_API_ASSET_ID"),
+		os.Getenv("ANYPOINT_API_ASSET_VERSION"), appName, autoApprove)
+}
@@ -0,0 +1,340 @@
+package anypoint
+
+import (
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"strconv"
+)
+
+const (
+	endpointModeRaw   = "raw"
+	endpointModeProxy = "proxy"
+	endpointModeMule4 = "mule4"
+)
+
+func resourceAPIInstance() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceAPIInstanceCreate,
+		Read:          resourceAPIInstanceRead,
+		Update:        resourceAPIInstanceUpdate,
+		Delete:        resourceAPIInstanceDelete,
+		CustomizeDiff: resourceAPIInstanceCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importEnvScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group the API instance belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The Exchange group of the asset. Defaults to org_id",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"asset_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"asset_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_label": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"technology": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "mule4",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"mule4", "flexGateway"}, false),
+			},
+			"deployment_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Where the API runs: CH (CloudHub), HY (hybrid) or RF (Runtime Fabric)",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{sdk.DeploymentTypeCloudHub, sdk.DeploymentTypeHybrid, sdk.DeploymentTypeRuntimeFabric}, false),
+			},
+			"endpoint": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": &schema.Schema{
+							Type:         schema.TypeString,
+							Description:  "raw (Mule 3 basic endpoint), proxy (a proxy in front of uri) or mule4 (Mule 4 autodiscovery)",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{endpointModeRaw, endpointModeProxy, endpointModeMule4}, false),
+						},
+						"uri": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The implementation URI",
+							Optional:    true,
+						},
+						"proxy_uri": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The URI the proxy listens on, only in proxy mode. Example: http://0.0.0.0:8081/",
+							Optional:    true,
+						},
+						"api_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "http",
+							ValidateFunc: validation.StringInSlice([]string{"http", "raml", "wsdl"}, false),
+						},
+						"is_cloudhub": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"response_timeout": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "Response timeout of the proxy in milliseconds",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"target_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The server, cluster, fabric or Flex Gateway (its gateway_id) the proxy is deployed to. Only for proxy endpoints",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"target_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"autodiscovery_instance_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAPIInstanceCreate(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+
+	groupID := orgID
+	if val, isSet := d.GetOk("group_id"); isSet {
+		groupID = val.(string)
+	}
+
+	api := getAPIInstanceFromData(d)
+	api.Spec = &sdk.APISpec{
+		GroupID: groupID,
+		AssetID: d.Get("asset_id").(string),
+		Version: d.Get("asset_version").(string),
+	}
+
+	created, err := apim.CreateAPIInstance(orgID, envID, api)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(created.ID))
+
+	if targetID, isSet := d.GetOk("target_id"); isSet {
+		deployment, err := apim.DeployAPIProxy(orgID, envID, created.ID, sdk.APIDeployment{
+			EnvironmentID:   envID,
+			Type:            d.Get("deployment_type").(string),
+			ExpectedStatus:  "deployed",
+			TargetID:        targetID.(string),
+			TargetName:      d.Get("target_name").(string),
+			ApplicationName: created.AutodiscoveryInstanceName,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		d.Set("deployment_id", deployment.ID)
+	}
+
+	return resourceAPIInstanceRead(d, conf)
+}
+
+func resourceAPIInstanceRead(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	apiID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid API instance ID %q : %s", d.Id(), err)
+	}
+
+	api, err := apim.GetAPIInstance(d.Get("org_id").(string), d.Get("env_id").(string), apiID)
+
+	if sdk.IsNotFound(err) {
+		log.Printf("API instance %d not found. Removing it from the state", apiID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading API instance %d : %s", apiID, err)
+	}
+
+	d.Set("group_id", api.GroupID)
+	d.Set("asset_id", api.AssetID)
+	d.Set("asset_version", api.AssetVersion)
+	d.Set("instance_label", api.InstanceLabel)
+	d.Set("technology", api.Technology)
+	d.Set("status", api.Status)
+	d.Set("autodiscovery_instance_name", api.AutodiscoveryInstanceName)
+
+	if api.Endpoint != nil {
+		d.Set("deployment_type", api.Endpoint.DeploymentType)
+
+		if err := d.Set("endpoint", flattenEndpoint(api.Endpoint)); err != nil {
+			return err
+		}
+	}
+
+	deployments, err := apim.GetAPIDeployments(d.Get("org_id").(string), d.Get("env_id").(string), apiID)
+	if err != nil && !sdk.IsNotFound(err) {
+		return fmt.Errorf("error while reading the deployment of API instance %d : %s", apiID, err)
+	}
+
+	//A proxy is deployed to a single target
+	if len(deployments) > 0 {
+		d.Set("deployment_id", deployments[0].ID)
+		d.Set("target_id", deployments[0].TargetID)
+		d.Set("target_name", deployments[0].TargetName)
+	} else {
+		d.Set("deployment_id", 0)
+		d.Set("target_id", "")
+		d.Set("target_name", "")
+	}
+
+	return nil
+}
+
+func resourceAPIInstanceUpdate(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	apiID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid API instance ID %q : %s", d.Id(), err)
+	}
+
+	api := getAPIInstanceFromData(d)
+	api.ID = apiID
+	api.AssetVersion = d.Get("asset_version").(string)
+
+	if _, err := apim.UpdateAPIInstance(d.Get("org_id").(string), d.Get("env_id").(string), api); err != nil {
+		return err
+	}
+
+	return resourceAPIInstanceRead(d, conf)
+}
+
+func resourceAPIInstanceDelete(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	apiID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid API instance ID %q : %s", d.Id(), err)
+	}
+
+	return apim.DeleteAPIInstance(d.Get("org_id").(string), d.Get("env_id").(string), apiID)
+}
+
+func resourceAPIInstanceCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	endpoint := d.Get("endpoint").([]interface{})
+	if len(endpoint) == 0 || endpoint[0] == nil {
+		return nil
+	}
+
+	mode := endpoint[0].(map[string]interface{})["mode"].(string)
+	proxyURI := endpoint[0].(map[string]interface{})["proxy_uri"].(string)
+	_, hasTarget := d.GetOk("target_id")
+
+	if mode == endpointModeProxy && proxyURI == "" && d.NewValueKnown("endpoint.0.proxy_uri") {
+		return errors.New("endpoint.proxy_uri is required when the endpoint mode is proxy")
+	}
+
+	//The mode is read back from the endpoint, where a proxy URI means proxy mode
+	if mode != endpointModeProxy && proxyURI != "" {
+		return errors.New("endpoint.proxy_uri can only be set when the endpoint mode is proxy")
+	}
+
+	if mode != endpointModeProxy && hasTarget {
+		return errors.New("target_id can only be set when the endpoint mode is proxy")
+	}
+
+	return nil
+}
+
+func getAPIInstanceFromData(d *schema.ResourceData) sdk.APIInstance {
+	endpoint := d.Get("endpoint").([]interface{})[0].(map[string]interface{})
+	mode := endpoint["mode"].(string)
+
+	return sdk.APIInstance{
+		InstanceLabel: d.Get("instance_label").(string),
+		Technology:    d.Get("technology").(string),
+		Endpoint: &sdk.Endpoint{
+			Type:                endpoint["api_type"].(string),
+			Uri:                 endpoint["uri"].(string),
+			ProxyUri:            endpoint["proxy_uri"].(string),
+			IsCloudHub:          endpoint["is_cloudhub"].(bool),
+			ResponseTimeout:     endpoint["response_timeout"].(int),
+			MuleVersion4OrAbove: mode != endpointModeRaw,
+			DeploymentType:      d.Get("deployment_type").(string),
+		},
+	}
+}
+
+func flattenEndpoint(endpoint *sdk.Endpoint) []interface{} {
+	mode := endpointModeRaw
+	switch {
+	case endpoint.ProxyUri != "":
+		mode = endpointModeProxy
+	case endpoint.MuleVersion4OrAbove:
+		mode = endpointModeMule4
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":             mode,
+			"uri":              endpoint.Uri,
+			"proxy_uri":        endpoint.ProxyUri,
+			"api_type":         endpoint.Type,
+			"is_cloudhub":      endpoint.IsCloudHub,
+			"response_timeout": endpoint.ResponseTimeout,
+		},
+	}
+}
@@ -0,0 +1,97 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestAccAPIInstance_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+			testAccPreCheckVars(t, "ANYPOINT_API_ASSET_ID", "ANYPOINT_API_ASSET_VERSION")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAPIInstanceDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIInstanceConfig_basic("v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_api_instance.test", "instance_label", "v1"),
+					resource.TestCheckResourceAttr("anypoint_api_instance.test", "endpoint.0.mode", "mule4"),
+					resource.TestCheckResourceAttrSet("anypoint_api_instance.test", "autodiscovery_instance_name"),
+				),
+			},
+			{
+				Config: testAccAPIInstanceConfig_basic("v1-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_api_instance.test", "instance_label", "v1-updated"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_api_instance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_api_instance.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAPIInstanceDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	apim := provider.Meta().(*Config).AnypointClient.APIManager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_api_instance" {
+			continue
+		}
+
+		apiID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = apim.GetAPIInstance(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], apiID)
+
+		if err == nil {
+			return fmt.Errorf("Found API instance with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAPIInstanceConfig_basic(label string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_api_instance" "test" {
+			org_id          = "%s"
+			env_id          = "%s"
+			asset_id        = "%s"
+			asset_version   = "%s"
+			instance_label  = "%s"
+			deployment_type = "CH"
+
+			endpoint {
+				mode = "mule4"
+				uri  = "http://example.com/api"
+			}
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), os.Getenv("ANYPOINT_API_ASSET_ID"),
+		os.Getenv("ANYPOINT_API_ASSET_VERSION"), label)
+}
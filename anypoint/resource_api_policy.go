@@ -0,0 +1,240 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"strconv"
+	"strings"
+)
+
+func resourceAPIPolicy() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceAPIPolicyCreate,
+		Read:   resourceAPIPolicyRead,
+		Update: resourceAPIPolicyUpdate,
+		Delete: resourceAPIPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAPIScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the API instance the policy is applied to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The Exchange group of the policy template. Defaults to the MuleSoft provided policies",
+				Optional:    true,
+				Default:     sdk.MuleSoftPolicyGroupID,
+				ForceNew:    true,
+			},
+			"asset_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The policy template. Example: client-id-enforcement, rate-limiting, jwt-validation, ip-allowlist",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"asset_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"configuration": &schema.Schema{
+				Type:             schema.TypeString,
+				Description:      "The configuration of the policy as a JSON object. Use jsonencode or a heredoc",
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+			"pointcut": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Restricts the policy to some resources. The policy applies to the whole API when empty",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"methods": &schema.Schema{
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS", "TRACE", "CONNECT"}, false),
+							},
+						},
+						"resource_regex": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "Regular expression matched against the resource path. Example: /users/.*",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"order": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "The position of the policy in the execution chain, starting at 1",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"disabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"policy_template_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAPIPolicyCreate(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	policy, err := getAPIPolicyFromData(d)
+	if err != nil {
+		return err
+	}
+
+	created, err := apim.CreateAPIPolicy(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), policy)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(created.ID))
+
+	//The order is ignored on creation: policies are appended to the chain
+	if order, isSet := d.GetOk("order"); isSet && order.(int) != created.Order {
+		created.Order = order.(int)
+		if _, err := apim.UpdateAPIPolicy(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), created); err != nil {
+			return err
+		}
+	}
+
+	return resourceAPIPolicyRead(d, conf)
+}
+
+func resourceAPIPolicyRead(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	policyID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid policy ID %q : %s", d.Id(), err)
+	}
+
+	policy, err := apim.GetAPIPolicy(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), policyID)
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Policy %d not found. Removing it from the state", policyID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading policy %d : %s", policyID, err)
+	}
+
+	configuration, err := structure.FlattenJsonToString(policy.ConfigurationData)
+	if err != nil {
+		return fmt.Errorf("error while reading the configuration of policy %d : %s", policyID, err)
+	}
+
+	d.Set("group_id", policy.GroupID)
+	d.Set("asset_id", policy.AssetID)
+	d.Set("asset_version", policy.AssetVersion)
+	d.Set("configuration", configuration)
+	d.Set("order", policy.Order)
+	d.Set("disabled", policy.Disabled)
+	d.Set("policy_template_id", policy.PolicyTemplateID)
+
+	pointcuts := make([]interface{}, 0, len(policy.PointcutData))
+	for _, pointcut := range policy.PointcutData {
+		pointcuts = append(pointcuts, map[string]interface{}{
+			"methods":        strings.Split(pointcut.MethodRegex, "|"),
+			"resource_regex": pointcut.URITemplateRegex,
+		})
+	}
+
+	if err := d.Set("pointcut", pointcuts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAPIPolicyUpdate(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	policyID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid policy ID %q : %s", d.Id(), err)
+	}
+
+	policy, err := getAPIPolicyFromData(d)
+	if err != nil {
+		return err
+	}
+	policy.ID = policyID
+
+	if _, err := apim.UpdateAPIPolicy(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), policy); err != nil {
+		return err
+	}
+
+	return resourceAPIPolicyRead(d, conf)
+}
+
+func resourceAPIPolicyDelete(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	policyID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid policy ID %q : %s", d.Id(), err)
+	}
+
+	return apim.DeleteAPIPolicy(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), policyID)
+}
+
+func getAPIPolicyFromData(d *schema.ResourceData) (sdk.APIPolicy, error) {
+	configuration, err := structure.ExpandJsonFromString(d.Get("configuration").(string))
+	if err != nil {
+		return sdk.APIPolicy{}, fmt.Errorf("invalid policy configuration : %s", err)
+	}
+
+	policy := sdk.APIPolicy{
+		GroupID:           d.Get("group_id").(string),
+		AssetID:           d.Get("asset_id").(string),
+		AssetVersion:      d.Get("asset_version").(string),
+		ConfigurationData: configuration,
+		PointcutData:      []sdk.PolicyPointcut{},
+		Order:             d.Get("order").(int),
+		Disabled:          d.Get("disabled").(bool),
+	}
+
+	for _, p := range d.Get("pointcut").([]interface{}) {
+		pointcut := p.(map[string]interface{})
+		policy.PointcutData = append(policy.PointcutData, sdk.PolicyPointcut{
+			MethodRegex:      strings.Join(expandStringList(pointcut["methods"].(*schema.Set).List()), "|"),
+			URITemplateRegex: pointcut["resource_regex"].(string),
+		})
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,141 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestAccAPIPolicy_order(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+			testAccPreCheckVars(t, "ANYPOINT_API_ASSET_ID", "ANYPOINT_API_ASSET_VERSION")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAPIPolicyDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPIPolicyConfig_order(1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_api_policy.client_id", "order", "1"),
+					resource.TestCheckResourceAttr("anypoint_api_policy.rate_limiting", "order", "2"),
+					resource.TestCheckResourceAttr("anypoint_api_policy.rate_limiting", "pointcut.#", "1"),
+				),
+			},
+			{
+				Config: testAccAPIPolicyConfig_order(2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_api_policy.client_id", "order", "2"),
+					resource.TestCheckResourceAttr("anypoint_api_policy.rate_limiting", "order", "1"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_api_policy.rate_limiting",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_api_policy.rate_limiting"]
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.Attributes["api_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAPIPolicyDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	apim := provider.Meta().(*Config).AnypointClient.APIManager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_api_policy" {
+			continue
+		}
+
+		apiID, err := strconv.Atoi(rs.Primary.Attributes["api_id"])
+		if err != nil {
+			return err
+		}
+
+		policyID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = apim.GetAPIPolicy(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], apiID, policyID)
+
+		if err == nil {
+			return fmt.Errorf("Found policy with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAPIPolicyConfig_order(clientIDOrder, rateLimitingOrder int) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_api_instance" "test" {
+			org_id          = "%s"
+			env_id          = "%s"
+			asset_id        = "%s"
+			asset_version   = "%s"
+			deployment_type = "CH"
+
+			endpoint {
+				mode = "mule4"
+				uri  = "http://example.com/api"
+			}
+		}
+
+		resource "anypoint_api_policy" "client_id" {
+			org_id        = "${anypoint_api_instance.test.org_id}"
+			env_id        = "${anypoint_api_instance.test.env_id}"
+			api_id        = "${anypoint_api_instance.test.id}"
+			asset_id      = "client-id-enforcement"
+			asset_version = "1.2.1"
+			order         = %d
+
+			configuration = <<JSON
+{
+  "credentialsOriginHasHttpBasicAuthenticationHeader": "customExpression",
+  "clientIdExpression": "#[attributes.headers['client_id']]",
+  "clientSecretExpression": "#[attributes.headers['client_secret']]"
+}
+JSON
+		}
+
+		resource "anypoint_api_policy" "rate_limiting" {
+			org_id        = "${anypoint_api_policy.client_id.org_id}"
+			env_id        = "${anypoint_api_policy.client_id.env_id}"
+			api_id        = "${anypoint_api_policy.client_id.api_id}"
+			asset_id      = "rate-limiting"
+			asset_version = "1.2.1"
+			order         = %d
+
+			configuration = <<JSON
+{
+  "rateLimits": [{"maximumRequests": 100, "timePeriodInMilliseconds": 60000}],
+  "clusterizable": true,
+  "exposeHeaders": false
+}
+JSON
+
+			pointcut {
+				methods        = ["GET", "POST"]
+				resource_regex = "/orders/.*"
+			}
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), os.Getenv("ANYPOINT_API_ASSET_ID"),
+		os.Getenv("ANYPOINT_API_ASSET_VERSION"), clientIDOrder, rateLimitingOrder)
+}
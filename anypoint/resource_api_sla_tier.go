@@ -0,0 +1,193 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"strconv"
+)
+
+func resourceAPISLATier() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceAPISLATierCreate,
+		Read:   resourceAPISLATierRead,
+		Update: resourceAPISLATierUpdate,
+		Delete: resourceAPISLATierDelete,
+		Importer: &schema.ResourceImporter{
+			State: importAPIScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"api_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the API instance the SLA tier belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auto_approve": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether access requests for this tier are approved without review",
+				Optional:    true,
+				Default:     false,
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sdk.SLATierStatusActive,
+				ValidateFunc: validation.StringInSlice([]string{sdk.SLATierStatusActive, sdk.SLATierStatusDeprecated}, false),
+			},
+			"limit": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maximum_requests": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"time_period_in_milliseconds": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"visible": &schema.Schema{
+							Type:        schema.TypeBool,
+							Description: "Whether the limit is shown to consumers in Exchange",
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAPISLATierCreate(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	tier, err := apim.CreateSLATier(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), getSLATierFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(tier.ID))
+
+	return resourceAPISLATierRead(d, conf)
+}
+
+func resourceAPISLATierRead(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	tierID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid SLA tier ID %q : %s", d.Id(), err)
+	}
+
+	tier, err := apim.GetSLATier(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), tierID)
+
+	if sdk.IsNotFound(err) {
+		log.Printf("SLA tier %d not found. Removing it from the state", tierID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading SLA tier %d : %s", tierID, err)
+	}
+
+	d.Set("name", tier.Name)
+	d.Set("description", tier.Description)
+	d.Set("auto_approve", tier.AutoApprove)
+	d.Set("status", tier.Status)
+
+	limits := make([]interface{}, 0, len(tier.Limits))
+	for _, limit := range tier.Limits {
+		limits = append(limits, map[string]interface{}{
+			"maximum_requests":            limit.MaximumRequests,
+			"time_period_in_milliseconds": limit.TimePeriodInMilliseconds,
+			"visible":                     limit.Visible,
+		})
+	}
+
+	if err := d.Set("limit", limits); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAPISLATierUpdate(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	tierID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid SLA tier ID %q : %s", d.Id(), err)
+	}
+
+	tier := getSLATierFromData(d)
+	tier.ID = tierID
+
+	if _, err := apim.UpdateSLATier(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), tier); err != nil {
+		return err
+	}
+
+	return resourceAPISLATierRead(d, conf)
+}
+
+func resourceAPISLATierDelete(d *schema.ResourceData, conf interface{}) error {
+	apim := conf.(*Config).AnypointClient.APIManager
+
+	tierID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid SLA tier ID %q : %s", d.Id(), err)
+	}
+
+	return apim.DeleteSLATier(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("api_id").(int), tierID)
+}
+
+func getSLATierFromData(d *schema.ResourceData) sdk.SLATier {
+	tier := sdk.SLATier{
+		APIID:       d.Get("api_id").(int),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		AutoApprove: d.Get("auto_approve").(bool),
+		Status:      d.Get("status").(string),
+		Limits:      []sdk.SLALimit{},
+	}
+
+	for _, l := range d.Get("limit").([]interface{}) {
+		limit := l.(map[string]interface{})
+		tier.Limits = append(tier.Limits, sdk.SLALimit{
+			MaximumRequests:          limit["maximum_requests"].(int),
+			TimePeriodInMilliseconds: limit["time_period_in_milliseconds"].(int),
+			Visible:                  limit["visible"].(bool),
+		})
+	}
+
+	return tier
+}
@@ -0,0 +1,116 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestAccAPISLATier_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+			testAccPreCheckVars(t, "ANYPOINT_API_ASSET_ID", "ANYPOINT_API_ASSET_VERSION")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAPISLATierDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAPISLATierConfig_basic(false, 100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_api_sla_tier.test", "name", "Gold"),
+					resource.TestCheckResourceAttr("anypoint_api_sla_tier.test", "auto_approve", "false"),
+					resource.TestCheckResourceAttr("anypoint_api_sla_tier.test", "limit.0.maximum_requests", "100"),
+				),
+			},
+			{
+				Config: testAccAPISLATierConfig_basic(true, 500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_api_sla_tier.test", "auto_approve", "true"),
+					resource.TestCheckResourceAttr("anypoint_api_sla_tier.test", "limit.0.maximum_requests", "500"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_api_sla_tier.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_api_sla_tier.test"]
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.Attributes["api_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckAPISLATierDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	apim := provider.Meta().(*Config).AnypointClient.APIManager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_api_sla_tier" {
+			continue
+		}
+
+		apiID, err := strconv.Atoi(rs.Primary.Attributes["api_id"])
+		if err != nil {
+			return err
+		}
+
+		tierID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = apim.GetSLATier(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], apiID, tierID)
+
+		if err == nil {
+			return fmt.Errorf("Found SLA tier with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAPISLATierConfig_basic(autoApprove bool, maximumRequests int) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_api_instance" "test" {
+			org_id          = "%s"
+			env_id          = "%s"
+			asset_id        = "%s"
+			asset_version   = "%s"
+			deployment_type = "CH"
+
+			endpoint {
+				mode = "mule4"
+				uri  = "http://example.com/api"
+			}
+		}
+
+		resource "anypoint_api_sla_tier" "test" {
+			org_id       = "${anypoint_api_instance.test.org_id}"
+			env_id       = "${anypoint_api_instance.test.env_id}"
+			api_id       = "${anypoint_api_instance.test.id}"
+			name         = "Gold"
+			description  = "Gold tier"
+			auto_approve = %t
+
+			limit {
+				maximum_requests            = %d
+				time_period_in_milliseconds = 60000
+			}
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), os.Getenv("ANYPOINT_API_ASSET_ID"),
+		os.Getenv("ANYPOINT_API_ASSET_VERSION"), autoApprove, maximumRequests)
+}
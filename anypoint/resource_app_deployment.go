@@ -0,0 +1,300 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"time"
+)
+
+func resourceAppDeployment() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceAppDeploymentCreate,
+		Read:   resourceAppDeploymentRead,
+		Update: resourceAppDeploymentUpdate,
+		Delete: resourceAppDeploymentDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group the application belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"env_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the environment the application is deployed to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The name of the application",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"target_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "A shared space (e.g. cloudhub-us-east-1), a private space ID or a Runtime Fabric ID",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"runtime_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The Mule runtime version. Example: 4.4.0:20230320-1",
+				Required:    true,
+			},
+			"asset": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Exchange coordinates of the application to deploy",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"artifact_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"packaging": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "jar",
+						},
+					},
+				},
+			},
+			"replicas": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"vcores": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Required: true,
+			},
+			"clustered": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the replicas run in Mule clustered mode",
+				Optional:    true,
+			},
+			"properties": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"secure_properties": &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Properties that are masked by the platform and never shown in plan output",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"public_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"update_strategy": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "rolling keeps the old replicas until the new ones are up, recreate stops them first",
+				Optional:     true,
+				Default:      "rolling",
+				ValidateFunc: validation.StringInSlice([]string{"rolling", "recreate"}, false),
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The status of the deployment: APPLYING, APPLIED or FAILED",
+				Computed:    true,
+			},
+			"application_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"desired_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAppDeploymentCreate(d *schema.ResourceData, conf interface{}) error {
+	am := conf.(*Config).AnypointClient.ApplicationManager
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+
+	deployment, err := am.CreateDeployment(orgID, envID, getDeploymentFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(deployment.ID)
+
+	if err = waitForDeployment(am, orgID, envID, deployment.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceAppDeploymentRead(d, conf)
+}
+
+func resourceAppDeploymentRead(d *schema.ResourceData, conf interface{}) error {
+	am := conf.(*Config).AnypointClient.ApplicationManager
+
+	deployment, err := am.GetDeployment(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Deployment %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading deployment %s : %s", d.Id(), err)
+	}
+
+	settings := deployment.Target.DeploymentSettings
+	ref := deployment.Application.Ref
+
+	d.Set("name", deployment.Name)
+	d.Set("target_id", deployment.Target.TargetID)
+	d.Set("replicas", deployment.Target.Replicas)
+	d.Set("runtime_version", settings.RuntimeVersion)
+	d.Set("clustered", settings.Clustered)
+	d.Set("public_url", settings.HTTP.Inbound.PublicURL)
+	d.Set("update_strategy", settings.UpdateStrategy)
+	d.Set("vcores", deployment.Application.VCores)
+	d.Set("properties", deployment.Application.Properties())
+	d.Set("status", deployment.Status)
+	d.Set("application_status", deployment.Application.Status)
+	d.Set("desired_version", deployment.DesiredVersion)
+
+	asset := map[string]interface{}{
+		"group_id":    ref.GroupID,
+		"artifact_id": ref.ArtifactID,
+		"version":     ref.Version,
+		"packaging":   ref.Packaging,
+	}
+
+	if err := d.Set("asset", []interface{}{asset}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceAppDeploymentUpdate(d *schema.ResourceData, conf interface{}) error {
+	am := conf.(*Config).AnypointClient.ApplicationManager
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+
+	deployment := getDeploymentFromData(d)
+	deployment.ID = d.Id()
+
+	if _, err := am.UpdateDeployment(orgID, envID, deployment); err != nil {
+		return err
+	}
+
+	if err := waitForDeployment(am, orgID, envID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceAppDeploymentRead(d, conf)
+}
+
+func resourceAppDeploymentDelete(d *schema.ResourceData, conf interface{}) error {
+	am := conf.(*Config).AnypointClient.ApplicationManager
+
+	return am.DeleteDeployment(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+}
+
+func getDeploymentFromData(d *schema.ResourceData) sdk.Deployment {
+	name := d.Get("name").(string)
+	asset := d.Get("asset").([]interface{})[0].(map[string]interface{})
+
+	var properties, secureProperties map[string]string
+	if val, isSet := d.GetOk("properties"); isSet {
+		properties = expandStringMap(val)
+	}
+	if val, isSet := d.GetOk("secure_properties"); isSet {
+		secureProperties = expandStringMap(val)
+	}
+
+	return sdk.Deployment{
+		Name: name,
+		Target: sdk.DeploymentTarget{
+			Provider: sdk.TargetProviderMC,
+			TargetID: d.Get("target_id").(string),
+			Replicas: d.Get("replicas").(int),
+			DeploymentSettings: sdk.DeploymentSettings{
+				Clustered:      d.Get("clustered").(bool),
+				RuntimeVersion: d.Get("runtime_version").(string),
+				UpdateStrategy: d.Get("update_strategy").(string),
+				HTTP: sdk.DeploymentHTTP{
+					Inbound: sdk.DeploymentInbound{
+						PublicURL: d.Get("public_url").(string),
+					},
+				},
+			},
+		},
+		Application: sdk.DeploymentApplication{
+			Ref: sdk.DeploymentRef{
+				GroupID:    asset["group_id"].(string),
+				ArtifactID: asset["artifact_id"].(string),
+				Version:    asset["version"].(string),
+				Packaging:  asset["packaging"].(string),
+			},
+			DesiredState:  sdk.ApplicationStateStarted,
+			VCores:        d.Get("vcores").(float64),
+			Configuration: sdk.NewDeploymentConfiguration(name, properties, secureProperties),
+		},
+	}
+}
+
+//waitForDeployment polls the deployment until it has been applied and the application is running
+func waitForDeployment(am *sdk.ApplicationManager, orgID, envID, deploymentID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{sdk.DeploymentStatusApplying},
+		Target:     []string{sdk.DeploymentStatusApplied},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			deployment, err := am.GetDeployment(orgID, envID, deploymentID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			switch {
+			case deployment.Status == sdk.DeploymentStatusFailed:
+				return nil, "", fmt.Errorf("deployment %s failed", deploymentID)
+			case deployment.Status == sdk.DeploymentStatusApplied && deployment.Application.Status == sdk.ApplicationStatusRunning:
+				return deployment, sdk.DeploymentStatusApplied, nil
+			}
+
+			return deployment, sdk.DeploymentStatusApplying, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error while waiting for deployment %s to be applied : %s", deploymentID, err)
+	}
+
+	return nil
+}
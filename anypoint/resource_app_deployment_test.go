@@ -0,0 +1,94 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"testing"
+)
+
+func TestAccAppDeployment_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	appName := fmt.Sprintf("test-app-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+			testAccPreCheckVars(t, "ANYPOINT_DEPLOYMENT_TARGET", "ANYPOINT_APP_GROUP_ID", "ANYPOINT_APP_ARTIFACT_ID", "ANYPOINT_APP_VERSION")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckAppDeploymentDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppDeploymentConfig_basic(appName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_app_deployment.test", "status", sdk.DeploymentStatusApplied),
+					resource.TestCheckResourceAttr("anypoint_app_deployment.test", "replicas", "1"),
+				),
+			},
+			{
+				Config: testAccAppDeploymentConfig_basic(appName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_app_deployment.test", "replicas", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAppDeploymentDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	am := provider.Meta().(*Config).AnypointClient.ApplicationManager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_app_deployment" {
+			continue
+		}
+
+		_, err := am.GetDeployment(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found deployment with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccAppDeploymentConfig_basic(appName string, replicas int) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_app_deployment" "test" {
+			org_id          = "%s"
+			env_id          = "%s"
+			name            = "%s"
+			target_id       = "%s"
+			runtime_version = "4.4.0"
+			replicas        = %d
+			vcores          = 0.1
+
+			asset {
+				group_id    = "%s"
+				artifact_id = "%s"
+				version     = "%s"
+			}
+
+			properties {
+				"env" = "test"
+			}
+
+			secure_properties {
+				"db.password" = "s3cr3t"
+			}
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), appName, os.Getenv("ANYPOINT_DEPLOYMENT_TARGET"), replicas,
+		os.Getenv("ANYPOINT_APP_GROUP_ID"), os.Getenv("ANYPOINT_APP_ARTIFACT_ID"), os.Getenv("ANYPOINT_APP_VERSION"))
+}
@@ -4,10 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
 	"log"
 )
 
+const (
+	bgReparentMove    = "move"
+	bgReparentReplace = "replace"
+)
+
 func resourceBusinessGroup() *schema.Resource {
 
 	return &schema.Resource{
@@ -17,6 +23,8 @@ func resourceBusinessGroup() *schema.Resource {
 		Delete: resourceBGDelete,
 		Exists: resourceBGExists,
 
+		CustomizeDiff: resourceBGCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:        schema.TypeString,
@@ -25,14 +33,21 @@ func resourceBusinessGroup() *schema.Resource {
 			},
 			"parent_path": &schema.Schema{
 				Type:        schema.TypeString,
-				Description: "The path to parent. Example: Company\\Retail\\APIs",
+				Description: "The path to parent. Example: Company\\Retail\\APIs. Changing it moves the business group as set by reparent_strategy",
 				Optional:    true,
 			},
 			"parent_org_id": &schema.Schema{
 				Type:        schema.TypeString,
-				Description: "The ID of the parent org",
+				Description: "The ID of the parent org. Takes precedence over parent_path. Changing it moves the business group as set by reparent_strategy",
 				Optional:    true,
 			},
+			"reparent_strategy": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "How a change of parent_org_id or parent_path is applied. move re-parents the business group in place, replace destroys it, losing its environments, applications and members, and creates it under the new parent",
+				Optional:     true,
+				Default:      bgReparentMove,
+				ValidateFunc: validation.StringInSlice([]string{bgReparentMove, bgReparentReplace}, false),
+			},
 			"owner_username": &schema.Schema{
 				Type:        schema.TypeString,
 				Description: "Username of the business group's Owner. Required only if the BG does not exist yet. Defaults to current username.",
@@ -43,39 +58,127 @@ func resourceBusinessGroup() *schema.Resource {
 				Type:        schema.TypeBool,
 				Description: "Whether or not the owner of the new org can create sub organizations",
 				Optional:    true,
+				Computed:    true,
 			},
 			"can_create_environments": &schema.Schema{
 				Type:        schema.TypeBool,
 				Description: "Whether the org owner can create environments",
 				Optional:    true,
+				Computed:    true,
 			},
 			"production_vcores": &schema.Schema{
 				Type:     schema.TypeFloat,
 				Optional: true,
+				Computed: true,
 			},
 			"sandbox_vcores": &schema.Schema{
 				Type:     schema.TypeFloat,
 				Optional: true,
+				Computed: true,
 			},
 			"design_vcores": &schema.Schema{
 				Type:     schema.TypeFloat,
 				Optional: true,
+				Computed: true,
 			},
 			"static_ips": &schema.Schema{
 				Type:     schema.TypeFloat,
 				Optional: true,
+				Computed: true,
 			},
 			"vpcs": &schema.Schema{
 				Type:     schema.TypeFloat,
 				Optional: true,
+				Computed: true,
 			},
 			"load_balancers": &schema.Schema{
 				Type:     schema.TypeFloat,
 				Optional: true,
+				Computed: true,
 			},
 			"vpns": &schema.Schema{
 				Type:     schema.TypeFloat,
 				Optional: true,
+				Computed: true,
+			},
+			"mq_messages": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Description: "Anypoint MQ messages granted to the business group",
+				Optional:    true,
+				Computed:    true,
+			},
+			"mq_requests": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Description: "Anypoint MQ API requests granted to the business group",
+				Optional:    true,
+				Computed:    true,
+			},
+			"object_store_request_units": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Description: "Object Store request units granted to the business group",
+				Optional:    true,
+				Computed:    true,
+			},
+			"object_store_keys": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Description: "Object Store keys granted to the business group",
+				Optional:    true,
+				Computed:    true,
+			},
+			"global_deployment": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether applications can be deployed to any CloudHub region",
+				Optional:    true,
+				Computed:    true,
+			},
+			"runtime_fabric": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the business group can use Runtime Fabric",
+				Optional:    true,
+				Computed:    true,
+			},
+			"api_manager": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the business group can manage APIs in API Manager",
+				Optional:    true,
+				Computed:    true,
+			},
+			"session_timeout": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "Minutes of inactivity after which users are logged out",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(15, 180),
+			},
+			"mfa_required": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether users of the business group must log in with multi-factor authentication",
+				Optional:    true,
+				Computed:    true,
+			},
+			"deletion_protection": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "When true, destroying the business group fails",
+				Optional:    true,
+				Default:     false,
+			},
+			"force_destroy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "When true, destroying the business group first deletes its sub organizations, environments and their CloudHub, CloudHub 2.0, Runtime Fabric and hybrid applications, bottom-up",
+				Optional:    true,
+				Default:     false,
+			},
+			"destroy_report": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Dry run of force_destroy: what would be deleted, in order. Only computed when force_destroy is true",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"domain": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The domain used in the login URL of the organization",
+				Optional:    true,
+				Computed:    true,
 			},
 		},
 	}
@@ -83,11 +186,10 @@ func resourceBusinessGroup() *schema.Resource {
 
 func resourceBGCreate(d *schema.ResourceData, conf interface{}) error {
 	newBgName := d.Get("name").(string)
-	parentPath := d.Get("parent_path").(string)
 	theConf := conf.(*Config)
 	client := theConf.AnypointClient
 
-	parentId, err := client.AccessManagement.FindBusinessGroup(parentPath)
+	parentId, err := resolveBGParentID(client.AccessManagement, d.Get("parent_org_id").(string), d.Get("parent_path").(string))
 	if err != nil {
 		return err
 	}
@@ -113,84 +215,234 @@ func resourceBGCreate(d *schema.ResourceData, conf interface{}) error {
 
 	d.SetId(newBG.ID)
 
-	return nil
+	//Settings are not accepted when creating an organization, they need a further update
+	_, hasTimeout := d.GetOk("session_timeout")
+	_, hasDomain := d.GetOk("domain")
+	if hasTimeout || hasDomain || d.Get("mfa_required").(bool) {
+		return resourceBGUpdate(d, conf)
+	}
+
+	return resourceBGRead(d, conf)
 }
 
 func resourceBGRead(d *schema.ResourceData, conf interface{}) error {
 	apClient := conf.(*Config).AnypointClient
-	path := d.Get("parent_path").(string)
-	bgName := d.Get("name")
-	bgID, err := apClient.AccessManagement.FindBusinessGroup(fmt.Sprintf("%s/%s", path, bgName))
 
+	bg, err := apClient.AccessManagement.GetBusinessGroupByID(d.Id())
 	if err != nil {
-		log.Printf("Error while searching for business group %s: %s", path, err)
 		return err
 	}
 
-	if bgID == "" {
-		groups := apClient.AccessManagement.CreateBusinessGroupPath(path)
-		if len(groups) > 1 {
-			path := ""
-			for idx, elem := range groups {
-				path = path + elem
-				if idx < (len(groups) - 1) {
-					path = path + "\\"
-				} else {
-					break
-				}
+	d.Set("name", bg.Name)
+	d.Set("session_timeout", bg.SessionTimeout)
+	d.Set("mfa_required", bg.MFARequired)
+	d.Set("domain", bg.Domain)
+
+	for _, e := range bgEntitlements {
+		d.Set(e.attribute, e.get(bg.Entitlements))
+	}
+
+	for _, f := range bgFeatures {
+		d.Set(f.attribute, f.get(bg.Entitlements))
+	}
+
+	if !d.Get("force_destroy").(bool) {
+		return d.Set("destroy_report", []string{})
+	}
+
+	//The report is informative: a sub organization that cannot be listed must not block plans of this one
+	steps, err := planBGForceDestroy(apClient, bg)
+	if err != nil {
+		log.Printf("[WARN] Cannot compute the destroy report of business group %s, keeping the previous one : %s", bg.ID, err)
+		return nil
+	}
+
+	report := make([]string, 0, len(steps))
+	for _, step := range steps {
+		report = append(report, step.String())
+	}
+
+	return d.Set("destroy_report", report)
+}
+
+func planBGForceDestroy(client *sdk.AnypointClient, bg sdk.BusinessGroup) ([]teardownStep, error) {
+	hierarchy, err := client.AccessManagement.GetBusinessGroupHierarchy(bg.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return planBGTeardown(hierarchy, bg.Name, listBGEnvironmentContents(client))
+}
+
+//resolveBGParentID returns parentOrgID when set, otherwise the ID of the business group found at parentPath
+func resolveBGParentID(auth *sdk.AccessManagement, parentOrgID, parentPath string) (string, error) {
+	if parentOrgID != "" {
+		return parentOrgID, nil
+	}
+
+	parentID, err := auth.FindBusinessGroup(parentPath)
+	if err != nil {
+		return "", err
+	}
+
+	if parentID == "" {
+		return "", fmt.Errorf("parent business group [%s] does not exist", parentPath)
+	}
+
+	return parentID, nil
+}
+
+//getEntitlementsFromData returns the entitlements set in the configuration. The others are left out so that
+//the API applies its defaults
+func getEntitlementsFromData(data *schema.ResourceData) sdk.Entitlements {
+	ents := sdk.Entitlements{}
+
+	for _, e := range bgEntitlements {
+		if val, isSet := data.GetOk(e.attribute); isSet {
+			e.set(&ents, val.(float64))
+		}
+	}
+
+	for _, f := range bgFeatures {
+		if val, isSet := data.GetOk(f.attribute); isSet {
+			f.set(&ents, val.(bool))
+		}
+	}
+
+	return ents
+}
+
+//resourceBGCustomizeDiff checks at plan time that the parent business group can grant the entitlements
+//requested for this business group
+func resourceBGCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	ents, changed := knownBGEntitlements(d)
+
+	client := conf.(*Config).AnypointClient
+	parentKnown := d.NewValueKnown("parent_org_id") && d.NewValueKnown("parent_path")
+	var parentID string
+
+	if d.Id() != "" {
+		bg, err := client.AccessManagement.GetBusinessGroupByID(d.Id())
+		if err != nil {
+			return err
+		}
+		parentID = bg.ParentOrgId
+
+		if parentKnown && (d.HasChange("parent_org_id") || d.HasChange("parent_path")) {
+			newParentID, err := resolveBGParentID(client.AccessManagement, d.Get("parent_org_id").(string), d.Get("parent_path").(string))
+			if err != nil {
+				return err
 			}
-			if id, err := apClient.AccessManagement.FindBusinessGroup(path); id == "" && err != nil {
-				return fmt.Errorf("Parent business group [%s] does not exits", path)
+
+			if newParentID != parentID {
+				if d.Get("reparent_strategy").(string) == bgReparentReplace {
+					log.Printf("[WARN] Business group %s is replaced to move it under %s: its environments, applications and members are lost", d.Id(), newParentID)
+
+					for _, attr := range []string{"parent_org_id", "parent_path"} {
+						if d.HasChange(attr) {
+							if err := d.ForceNew(attr); err != nil {
+								return err
+							}
+						}
+					}
+				}
+
+				return checkBGMove(client.AccessManagement, d.Id(), newParentID, ents)
 			}
 		}
+	} else {
+		if !parentKnown {
+			return nil
+		}
+
+		var err error
+		parentID, err = resolveBGParentID(client.AccessManagement, d.Get("parent_org_id").(string), d.Get("parent_path").(string))
+		if err != nil {
+			return err
+		}
+	}
 
+	if parentID == "" {
+		//The root organization has no parent to take entitlements from
+		return nil
 	}
 
-	d.SetId(bgID)
+	parent, err := client.AccessManagement.GetBusinessGroupHierarchy(parentID)
+	if err != nil {
+		return err
+	}
 
-	return nil
+	return checkBGEntitlements(parent, d.Id(), ents, changed)
 }
 
-func getEntitlementsFromData(data *schema.ResourceData) sdk.Entitlements {
+//knownBGEntitlements returns the entitlements whose new value is known at plan time, and a function telling
+//which of them change. The others, computed because they are left out of the configuration or interpolated
+//from resources yet to be created, are not checked
+func knownBGEntitlements(d *schema.ResourceDiff) (sdk.Entitlements, func(attribute string) bool) {
 	ents := sdk.Entitlements{}
+	known := map[string]bool{}
 
-	if val, isSet := data.GetOk("can_create_sub_orgs"); isSet {
-		ents.CreateSubOrgs = val.(bool)
+	for _, e := range bgEntitlements {
+		if d.NewValueKnown(e.attribute) {
+			known[e.attribute] = true
+			e.set(&ents, d.Get(e.attribute).(float64))
+		}
 	}
 
-	if val, isSet := data.GetOk("can_create_environments"); isSet {
-		ents.CreateEnvironments = val.(bool)
+	for _, f := range bgFeatures {
+		if d.NewValueKnown(f.attribute) {
+			known[f.attribute] = true
+			f.set(&ents, d.Get(f.attribute).(bool))
+		}
 	}
 
-	if val, isSet := data.GetOk("proudction_vcores"); isSet {
-		ents.ProductionVCores = sdk.EntitlementStatus{Assigned: val.(float64)}
+	changed := func(attribute string) bool {
+		return known[attribute] && d.HasChange(attribute)
 	}
 
-	if val, isSet := data.GetOk("design_vcores"); isSet {
-		ents.DesignVCores = sdk.EntitlementStatus{Assigned: val.(float64)}
+	return ents, changed
+}
+
+//checkBGMove fails when business group bgID cannot be moved under newParentID: the new parent is the
+//business group itself or one of its descendants, or it cannot grant all of the entitlements the business
+//group holds
+func checkBGMove(auth *sdk.AccessManagement, bgID, newParentID string, ents sdk.Entitlements) error {
+	bg, err := auth.GetBusinessGroupHierarchy(bgID)
+	if err != nil {
+		return err
 	}
 
-	if val, isSet := data.GetOk("load_balancers"); isSet {
-		ents.LoadBalancer = sdk.EntitlementStatus{Assigned: val.(float64)}
+	if hierarchyContains(bg, newParentID) {
+		return fmt.Errorf("cannot move business group %s (%s) under %s : it is the business group itself or one of its descendants",
+			bg.Name, bgID, newParentID)
 	}
 
-	if val, isSet := data.GetOk("production_vcores"); isSet {
-		ents.ProductionVCores = sdk.EntitlementStatus{Assigned: val.(float64)}
+	parent, err := auth.GetBusinessGroupHierarchy(newParentID)
+	if err != nil {
+		return err
 	}
 
-	if val, isSet := data.GetOk("static_ips"); isSet {
-		ents.StaticIPs = sdk.EntitlementStatus{Assigned: val.(float64)}
+	all := func(string) bool { return true }
+	if err := checkBGEntitlements(parent, bgID, ents, all); err != nil {
+		return fmt.Errorf("cannot move business group %s (%s) under %s (%s) : %s", bg.Name, bgID, parent.Name, parent.ID, err)
 	}
 
-	if val, isSet := data.GetOk("vpcs"); isSet {
-		ents.VPCs = sdk.EntitlementStatus{Assigned: val.(float64)}
+	return nil
+}
+
+//hierarchyContains returns whether the business group id is bg or one of its descendants
+func hierarchyContains(bg sdk.BusinessGroup, id string) bool {
+	if bg.ID == id {
+		return true
 	}
 
-	if val, isSet := data.GetOk("vpns"); isSet {
-		ents.VPNs = sdk.EntitlementStatus{Assigned: val.(float64)}
+	for _, sub := range bg.SubOrganizations {
+		if hierarchyContains(sub, id) {
+			return true
+		}
 	}
 
-	return ents
+	return false
 }
 
 func resourceBGDelete(d *schema.ResourceData, conf interface{}) error {
@@ -198,12 +450,25 @@ func resourceBGDelete(d *schema.ResourceData, conf interface{}) error {
 
 	if bgID := d.Id(); bgID != "" {
 
+		if d.Get("deletion_protection").(bool) {
+			return fmt.Errorf("business group %s has deletion_protection enabled. Set it to false and apply before destroying it", bgID)
+		}
+
 		bg, err := apClient.AccessManagement.GetBusinessGroupByID(bgID)
 
 		if err != nil {
 			return fmt.Errorf("error deleting business group. Unable to find business group with id '%s' : %s", bgID, err)
 		}
 
+		if d.Get("force_destroy").(bool) {
+			steps, err := planBGForceDestroy(apClient, bg)
+			if err != nil {
+				return fmt.Errorf("error while planning the force destroy of business group with id '%s' : %s", bgID, err)
+			}
+
+			return runBGTeardown(apClient, steps)
+		}
+
 		if err = apClient.AccessManagement.DeleteBusinessGroup(bg.ID); err != nil {
 			return fmt.Errorf("error while deleting business group with id '%s' : %s", bgID, err)
 		}
@@ -215,9 +480,64 @@ func resourceBGDelete(d *schema.ResourceData, conf interface{}) error {
 }
 
 func resourceBGUpdate(d *schema.ResourceData, conf interface{}) error {
-	//apClient := conf.(*Config).AnypointClient
+	apClient := conf.(*Config).AnypointClient
+
+	bg, err := apClient.AccessManagement.GetBusinessGroupByID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	//Only the entitlements that changed are overwritten, the others are sent back as they are
+	ents := bg.Entitlements
+	for _, e := range bgEntitlements {
+		if d.HasChange(e.attribute) {
+			e.set(&ents, d.Get(e.attribute).(float64))
+		}
+	}
+
+	for _, f := range bgFeatures {
+		if d.HasChange(f.attribute) {
+			f.set(&ents, d.Get(f.attribute).(bool))
+		}
+	}
+
+	//A new parent is sent along with the entitlements so that the business group is moved and resized at once
+	parentID := bg.ParentOrgId
+	if d.HasChange("parent_org_id") || d.HasChange("parent_path") {
+		parentID, err = resolveBGParentID(apClient.AccessManagement, d.Get("parent_org_id").(string), d.Get("parent_path").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	update := sdk.BusinessGroup{
+		ID:             bg.ID,
+		Name:           d.Get("name").(string),
+		OwnerId:        bg.OwnerId,
+		ParentOrgId:    parentID,
+		Entitlements:   ents,
+		SessionTimeout: d.Get("session_timeout").(int),
+		MFARequired:    d.Get("mfa_required").(bool),
+		Domain:         d.Get("domain").(string),
+	}
+
+	if _, err := apClient.AccessManagement.UpdateBusinessGroup(update); err != nil {
+		return err
+	}
+
+	if parentID != bg.ParentOrgId {
+		moved, err := apClient.AccessManagement.GetBusinessGroupByID(bg.ID)
+		if err != nil {
+			return err
+		}
+
+		if moved.ParentOrgId != parentID {
+			return fmt.Errorf("business group %s (%s) was updated but not moved under %s: the organizations API ignored the new parent. Set reparent_strategy = \"%s\" to recreate it under the new parent instead",
+				bg.Name, bg.ID, parentID, bgReparentReplace)
+		}
+	}
 
-	return errors.New("must implement resourceBGUpdate")
+	return resourceBGRead(d, conf)
 }
 
 func resourceBGExists(d *schema.ResourceData, conf interface{}) (bool, error) {
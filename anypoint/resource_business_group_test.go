@@ -2,6 +2,7 @@ package anypoint
 
 import (
 	"fmt"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -32,6 +33,111 @@ func TestAccBusinessGroup_create(t *testing.T) {
 	})
 
 }
+func TestAccBusinessGroup_settings(t *testing.T) {
+	var providers []*schema.Provider
+
+	bgName := fmt.Sprintf("test-bg-settings-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	parentPath := "RootOrg/Sub Org 2/Sub Org 2.1"
+	bg := sdk.BusinessGroup{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckBusinessGroupDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusinessGroupConfig_settings(bgName, parentPath, 30, 0.1),
+				Check: resource.ComposeTestCheckFunc(
+					testBGExists("ap_bg.test", &bg),
+					resource.TestCheckResourceAttr("ap_bg.test", "session_timeout", "30"),
+					resource.TestCheckResourceAttr("ap_bg.test", "sandbox_vcores", "0.1")),
+			},
+			{
+				Config: testAccBusinessGroupConfig_settings(bgName, parentPath, 60, 0.2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ap_bg.test", "session_timeout", "60"),
+					resource.TestCheckResourceAttr("ap_bg.test", "sandbox_vcores", "0.2")),
+			},
+		},
+	})
+}
+
+func TestAccBusinessGroup_forceDestroy(t *testing.T) {
+	var providers []*schema.Provider
+
+	bgName := fmt.Sprintf("test-bg-force-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	parentPath := "RootOrg/Sub Org 2/Sub Org 2.1"
+	bg := sdk.BusinessGroup{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckBusinessGroupDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusinessGroupConfig_forceDestroy(bgName, parentPath),
+				Check: resource.ComposeTestCheckFunc(
+					testBGExists("ap_bg.test", &bg),
+					resource.TestCheckResourceAttrSet("ap_bg.test", "destroy_report.0")),
+			},
+		},
+	})
+}
+
+func TestAccBusinessGroup_move(t *testing.T) {
+	var providers []*schema.Provider
+
+	bgName := fmt.Sprintf("test-bg-move-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	bg := sdk.BusinessGroup{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckBusinessGroupDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusinessGroupConfig_basic(bgName, "RootOrg/Sub Org 2/Sub Org 2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testBGExists("ap_bg.test", &bg)),
+			},
+			{
+				Config: testAccBusinessGroupConfig_basic(bgName, "RootOrg/Sub Org 2"),
+				Check: resource.ComposeTestCheckFunc(
+					testBGExists("ap_bg.test", &bg),
+					testBGParent("ap_bg.test", "RootOrg/Sub Org 2")),
+			},
+		},
+	})
+}
+
+//testBGParent checks that the business group has been moved in place under parentPath
+func testBGParent(resourceName, parentPath string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		auth := testAccProvider.Meta().(*Config).AnypointClient.AccessManagement
+
+		parentID, err := auth.FindBusinessGroup(parentPath)
+		if err != nil {
+			return err
+		}
+
+		bg, err := auth.GetBusinessGroupByID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if bg.ParentOrgId != parentID {
+			return fmt.Errorf("Business Group %s is under %s instead of %s (%s)", bg.ID, bg.ParentOrgId, parentPath, parentID)
+		}
+
+		return nil
+	}
+}
+
 func testBGExists(resourceName string, bg *sdk.BusinessGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		ress := s.RootModule().Resources
@@ -98,3 +204,81 @@ func testAccBusinessGroupConfig_basic(bgName, parentPath string) string {
 		}
 	`, bgName, parentPath)
 }
+
+func testAccBusinessGroupConfig_settings(bgName, parentPath string, sessionTimeout int, sandboxVCores float64) string {
+
+	return fmt.Sprintf(`
+		resource "ap_bg" "test" {
+			name            = "%s"
+			parent_path     = "%s"
+			session_timeout = %d
+			sandbox_vcores  = %v
+		}
+	`, bgName, parentPath, sessionTimeout, sandboxVCores)
+}
+
+func testAccBusinessGroupConfig_forceDestroy(bgName, parentPath string) string {
+
+	return fmt.Sprintf(`
+		resource "ap_bg" "test" {
+			name          = "%s"
+			parent_path   = "%s"
+			force_destroy = true
+		}
+	`, bgName, parentPath)
+}
+
+func TestKnownBGEntitlements_partialCreate(t *testing.T) {
+	parent := sdk.BusinessGroup{
+		ID:           "parent-id",
+		Name:         "Retail",
+		Entitlements: sdk.Entitlements{ProductionVCores: sdk.EntitlementStatus{Assigned: 10}},
+	}
+
+	cases := []struct {
+		vCores float64
+		fails  bool
+	}{
+		{100, true},
+		{10, false},
+	}
+
+	for _, c := range cases {
+		var changed func(string) bool
+		r := &schema.Resource{
+			Schema: resourceBusinessGroup().Schema,
+			CustomizeDiff: func(d *schema.ResourceDiff, meta interface{}) error {
+				var ents sdk.Entitlements
+				ents, changed = knownBGEntitlements(d)
+				return checkBGEntitlements(parent, "", ents, changed)
+			},
+		}
+
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"name":              "APIs",
+			"parent_org_id":     parent.ID,
+			"production_vcores": c.vCores,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = r.Diff(nil, terraform.NewResourceConfig(raw), nil)
+
+		if c.fails && err == nil {
+			t.Errorf("expected %v production vCores to exceed the capacity of the parent", c.vCores)
+		}
+
+		if !c.fails && err != nil {
+			t.Errorf("unexpected error for %v production vCores : %s", c.vCores, err)
+		}
+
+		if !changed("production_vcores") {
+			t.Errorf("expected production_vcores to be checked on create")
+		}
+
+		if changed("sandbox_vcores") {
+			t.Errorf("expected sandbox_vcores, left out of the configuration, not to be checked")
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceCertificate() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceCertificateCreate,
+		Read:          resourceCertificateRead,
+		Update:        resourceCertificateUpdate,
+		Delete:        resourceCertificateDelete,
+		CustomizeDiff: resourceCertificateCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importSecretGroupScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"file_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Path to the PEM certificate",
+				Required:    true,
+			},
+			"content_hash": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "SHA-256 of the uploaded file. A change uploads it again",
+				Computed:    true,
+			},
+			"path": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expiration_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCertificateCreate(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	id, err := sm.CreateCertificate(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), getCertificateFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceCertificateRead(d, conf)
+}
+
+func resourceCertificateRead(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	certificate, err := sm.GetCertificate(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Certificate %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading certificate %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", certificate.Name)
+	d.Set("path", certificate.Meta.Path)
+	d.Set("expiration_date", certificate.ExpirationDate)
+
+	return nil
+}
+
+func resourceCertificateUpdate(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	err := sm.UpdateCertificate(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), d.Id(), getCertificateFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceCertificateRead(d, conf)
+}
+
+func resourceCertificateDelete(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	return sm.DeleteSecret(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), sdk.SecretTypeCertificate, d.Id())
+}
+
+func resourceCertificateCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	return setSecretContentHash(d, "file_path")
+}
+
+func getCertificateFromData(d *schema.ResourceData) sdk.Certificate {
+	return sdk.Certificate{
+		Name:     d.Get("name").(string),
+		Type:     sdk.StoreTypePEM,
+		FilePath: d.Get("file_path").(string),
+	}
+}
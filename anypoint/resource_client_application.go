@@ -0,0 +1,152 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"strconv"
+)
+
+func resourceClientApplication() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceClientApplicationCreate,
+		Read:   resourceClientApplicationRead,
+		Update: resourceClientApplicationUpdate,
+		Delete: resourceClientApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: importOrgScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the client application",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"redirect_uris": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "OAuth 2.0 redirect URIs of the application",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"grant_types": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"authorization_code", "implicit", "password", "client_credentials", "refresh_token"}, false),
+				},
+			},
+			"client_id": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceClientApplicationCreate(d *schema.ResourceData, conf interface{}) error {
+	ex := conf.(*Config).AnypointClient.Exchange
+
+	app, err := ex.CreateClientApplication(d.Get("org_id").(string), getClientApplicationFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(app.ID))
+
+	return resourceClientApplicationRead(d, conf)
+}
+
+func resourceClientApplicationRead(d *schema.ResourceData, conf interface{}) error {
+	ex := conf.(*Config).AnypointClient.Exchange
+
+	appID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid client application ID %q : %s", d.Id(), err)
+	}
+
+	app, err := ex.GetClientApplication(d.Get("org_id").(string), appID)
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Client application %d not found. Removing it from the state", appID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading client application %d : %s", appID, err)
+	}
+
+	d.Set("name", app.Name)
+	d.Set("description", app.Description)
+	d.Set("url", app.URL)
+	d.Set("redirect_uris", app.RedirectURIs)
+	d.Set("grant_types", app.GrantTypes)
+	d.Set("client_id", app.ClientID)
+	d.Set("client_secret", app.ClientSecret)
+
+	return nil
+}
+
+func resourceClientApplicationUpdate(d *schema.ResourceData, conf interface{}) error {
+	ex := conf.(*Config).AnypointClient.Exchange
+
+	appID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid client application ID %q : %s", d.Id(), err)
+	}
+
+	app := getClientApplicationFromData(d)
+	app.ID = appID
+
+	if _, err := ex.UpdateClientApplication(d.Get("org_id").(string), app); err != nil {
+		return err
+	}
+
+	return resourceClientApplicationRead(d, conf)
+}
+
+func resourceClientApplicationDelete(d *schema.ResourceData, conf interface{}) error {
+	ex := conf.(*Config).AnypointClient.Exchange
+
+	appID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid client application ID %q : %s", d.Id(), err)
+	}
+
+	return ex.DeleteClientApplication(d.Get("org_id").(string), appID)
+}
+
+func getClientApplicationFromData(d *schema.ResourceData) sdk.ClientApplication {
+	return sdk.ClientApplication{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		URL:          d.Get("url").(string),
+		RedirectURIs: expandStringList(d.Get("redirect_uris").([]interface{})),
+		GrantTypes:   expandStringList(d.Get("grant_types").(*schema.Set).List()),
+	}
+}
@@ -0,0 +1,93 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestAccClientApplication_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	appName := fmt.Sprintf("test-app-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckVars(t, "ANYPOINT_ORG_ID")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckClientApplicationDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClientApplicationConfig_basic(appName, "First description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_client_application.test", "name", appName),
+					resource.TestCheckResourceAttrSet("anypoint_client_application.test", "client_id"),
+					resource.TestCheckResourceAttrSet("anypoint_client_application.test", "client_secret"),
+				),
+			},
+			{
+				Config: testAccClientApplicationConfig_basic(appName, "Second description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_client_application.test", "description", "Second description"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_client_application.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_client_application.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["org_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckClientApplicationDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	ex := provider.Meta().(*Config).AnypointClient.Exchange
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_client_application" {
+			continue
+		}
+
+		appID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = ex.GetClientApplication(rs.Primary.Attributes["org_id"], appID)
+
+		if err == nil {
+			return fmt.Errorf("Found client application with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccClientApplicationConfig_basic(appName, description string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_client_application" "test" {
+			org_id        = "%s"
+			name          = "%s"
+			description   = "%s"
+			redirect_uris = ["https://example.com/callback"]
+			grant_types   = ["client_credentials"]
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), appName, description)
+}
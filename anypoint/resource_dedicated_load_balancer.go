@@ -0,0 +1,327 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+//dlbPatchPaths maps the attributes that can be updated in place to their JSON patch path
+var dlbPatchPaths = map[string]string{
+	"ip_whitelist":         "/ipWhitelist",
+	"http_mode":            "/httpMode",
+	"tls_v1":               "/tlsv1",
+	"upstream_tls_v12":     "/upstreamTlsv12",
+	"keep_url_encoding":    "/keepUrlEncoding",
+	"default_ssl_endpoint": "/defaultSslEndpoint",
+	"workers":              "/workers",
+	"ssl_endpoint":         "/sslEndpoints",
+}
+
+func resourceDedicatedLoadBalancer() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceDLBCreate,
+		Read:   resourceDLBRead,
+		Update: resourceDLBUpdate,
+		Delete: resourceDLBDelete,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the VPC. Its load_balancers entitlement is consumed",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The name of the load balancer. It is also its <name>.lb.anypointdns.net domain",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ip_whitelist": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "CIDRs allowed to reach the load balancer",
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.CIDRNetwork(0, 32),
+				},
+			},
+			"http_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "How plain HTTP requests are handled: on, off or redirect",
+				Optional:     true,
+				Default:      "off",
+				ValidateFunc: validation.StringInSlice([]string{"on", "off", "redirect"}, false),
+			},
+			"tls_v1": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether TLS 1.0 is accepted from clients",
+				Optional:    true,
+			},
+			"upstream_tls_v12": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether TLS 1.2 is enforced towards the applications",
+				Optional:    true,
+			},
+			"keep_url_encoding": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"default_ssl_endpoint": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Index of the ssl_endpoint served to clients not sending SNI",
+				Optional:    true,
+			},
+			"workers": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "Number of load balancer instances",
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntBetween(2, 4),
+			},
+			"ssl_endpoint": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"public_key": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The PEM encoded certificate chain",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"private_key": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The PEM encoded private key",
+							Required:    true,
+							Sensitive:   true,
+						},
+						"verify_client_mode": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "off",
+							ValidateFunc: validation.StringInSlice([]string{"off", "optional", "on"}, false),
+						},
+						"public_key_cn": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mapping": &schema.Schema{
+							Type:        schema.TypeList,
+							Description: "Mapping rules, evaluated in order. Patterns may use the {app} and {version} placeholders",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"input_uri": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"app_name": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"app_uri": &schema.Schema{
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  "/",
+									},
+									"upstream_protocol": &schema.Schema{
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "http",
+										ValidateFunc: validation.StringInSlice([]string{"http", "https", "ws", "wss"}, false),
+									},
+									"upstream_port": &schema.Schema{
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(0, 65535),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDLBCreate(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	lb, err := ch.CreateLoadBalancer(d.Get("org_id").(string), getLoadBalancerFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(lb.ID)
+
+	return resourceDLBRead(d, conf)
+}
+
+func resourceDLBRead(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	lb, err := ch.GetLoadBalancer(d.Get("org_id").(string), d.Get("vpc_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Dedicated load balancer %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading dedicated load balancer %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", lb.Name)
+	d.Set("ip_whitelist", lb.IPWhitelist)
+	d.Set("http_mode", lb.HTTPMode)
+	d.Set("tls_v1", lb.TLSv1)
+	d.Set("upstream_tls_v12", lb.UpstreamTLSv12)
+	d.Set("keep_url_encoding", lb.KeepURLEncoding)
+	d.Set("default_ssl_endpoint", lb.DefaultSSLEndpoint)
+	d.Set("workers", lb.Workers)
+	d.Set("domain", lb.Domain)
+	d.Set("state", lb.State)
+
+	if err := d.Set("ssl_endpoint", flattenSSLEndpoints(d, lb.SSLEndpoints)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//resourceDLBUpdate patches the changed attributes in place. Only name and VPC require a new load balancer
+func resourceDLBUpdate(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+	lb := getLoadBalancerFromData(d)
+
+	values := map[string]interface{}{
+		"ip_whitelist":         lb.IPWhitelist,
+		"http_mode":            lb.HTTPMode,
+		"tls_v1":               lb.TLSv1,
+		"upstream_tls_v12":     lb.UpstreamTLSv12,
+		"keep_url_encoding":    lb.KeepURLEncoding,
+		"default_ssl_endpoint": lb.DefaultSSLEndpoint,
+		"workers":              lb.Workers,
+		"ssl_endpoint":         lb.SSLEndpoints,
+	}
+
+	ops := []sdk.PatchOperation{}
+	for attr, path := range dlbPatchPaths {
+		if d.HasChange(attr) {
+			ops = append(ops, sdk.PatchOperation{Op: sdk.PatchOpReplace, Path: path, Value: values[attr]})
+		}
+	}
+
+	if len(ops) > 0 {
+		if _, err := ch.PatchLoadBalancer(d.Get("org_id").(string), d.Get("vpc_id").(string), d.Id(), ops); err != nil {
+			return err
+		}
+	}
+
+	return resourceDLBRead(d, conf)
+}
+
+func resourceDLBDelete(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	return ch.DeleteLoadBalancer(d.Get("org_id").(string), d.Get("vpc_id").(string), d.Id())
+}
+
+func getLoadBalancerFromData(d *schema.ResourceData) sdk.LoadBalancer {
+	lb := sdk.LoadBalancer{
+		Name:               d.Get("name").(string),
+		VpcID:              d.Get("vpc_id").(string),
+		IPWhitelist:        expandStringList(d.Get("ip_whitelist").(*schema.Set).List()),
+		HTTPMode:           d.Get("http_mode").(string),
+		TLSv1:              d.Get("tls_v1").(bool),
+		UpstreamTLSv12:     d.Get("upstream_tls_v12").(bool),
+		KeepURLEncoding:    d.Get("keep_url_encoding").(bool),
+		DefaultSSLEndpoint: d.Get("default_ssl_endpoint").(int),
+		Workers:            d.Get("workers").(int),
+	}
+
+	for _, e := range d.Get("ssl_endpoint").([]interface{}) {
+		endpoint := e.(map[string]interface{})
+		sslEndpoint := sdk.SSLEndpoint{
+			PublicKey:        endpoint["public_key"].(string),
+			PrivateKey:       endpoint["private_key"].(string),
+			VerifyClientMode: endpoint["verify_client_mode"].(string),
+			Mappings:         []sdk.LoadBalancerMapping{},
+		}
+
+		for _, m := range endpoint["mapping"].([]interface{}) {
+			mapping := m.(map[string]interface{})
+			sslEndpoint.Mappings = append(sslEndpoint.Mappings, sdk.LoadBalancerMapping{
+				InputURI:         mapping["input_uri"].(string),
+				AppName:          mapping["app_name"].(string),
+				AppURI:           mapping["app_uri"].(string),
+				UpstreamProtocol: mapping["upstream_protocol"].(string),
+				UpstreamPort:     mapping["upstream_port"].(int),
+			})
+		}
+
+		lb.SSLEndpoints = append(lb.SSLEndpoints, sslEndpoint)
+	}
+
+	return lb
+}
+
+//flattenSSLEndpoints keeps the certificates and keys from the state since the API only returns
+//their digests
+func flattenSSLEndpoints(d *schema.ResourceData, endpoints []sdk.SSLEndpoint) []interface{} {
+	current := d.Get("ssl_endpoint").([]interface{})
+	result := make([]interface{}, 0, len(endpoints))
+
+	for i, endpoint := range endpoints {
+		mappings := make([]interface{}, 0, len(endpoint.Mappings))
+		for _, mapping := range endpoint.Mappings {
+			mappings = append(mappings, map[string]interface{}{
+				"input_uri":         mapping.InputURI,
+				"app_name":          mapping.AppName,
+				"app_uri":           mapping.AppURI,
+				"upstream_protocol": mapping.UpstreamProtocol,
+				"upstream_port":     mapping.UpstreamPort,
+			})
+		}
+
+		flattened := map[string]interface{}{
+			"verify_client_mode": endpoint.VerifyClientMode,
+			"public_key_cn":      endpoint.PublicKeyCN,
+			"mapping":            mappings,
+		}
+
+		if i < len(current) {
+			state := current[i].(map[string]interface{})
+			flattened["public_key"] = state["public_key"]
+			flattened["private_key"] = state["private_key"]
+		}
+
+		result = append(result, flattened)
+	}
+
+	return result
+}
@@ -0,0 +1,88 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"testing"
+)
+
+func TestAccDedicatedLoadBalancer_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	lbName := fmt.Sprintf("test-dlb-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckVars(t, "ANYPOINT_ORG_ID", "ANYPOINT_VPC_ID", "ANYPOINT_DLB_CERT", "ANYPOINT_DLB_KEY")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckDedicatedLoadBalancerDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDedicatedLoadBalancerConfig_basic(lbName, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_dedicated_load_balancer.test", "name", lbName),
+					resource.TestCheckResourceAttr("anypoint_dedicated_load_balancer.test", "ssl_endpoint.0.mapping.#", "1"),
+				),
+			},
+			{
+				Config: testAccDedicatedLoadBalancerConfig_basic(lbName, "redirect"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_dedicated_load_balancer.test", "http_mode", "redirect"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDedicatedLoadBalancerDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	ch := provider.Meta().(*Config).AnypointClient.CloudHub
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_dedicated_load_balancer" {
+			continue
+		}
+
+		_, err := ch.GetLoadBalancer(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["vpc_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found dedicated load balancer with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccDedicatedLoadBalancerConfig_basic(lbName, httpMode string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_dedicated_load_balancer" "test" {
+			org_id       = "%s"
+			vpc_id       = "%s"
+			name         = "%s"
+			http_mode    = "%s"
+			ip_whitelist = ["0.0.0.0/0"]
+
+			ssl_endpoint {
+				public_key  = "${file("%s")}"
+				private_key = "${file("%s")}"
+
+				mapping {
+					input_uri = "/{app}/"
+					app_name  = "{app}"
+					app_uri   = "/"
+				}
+			}
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_VPC_ID"), lbName, httpMode, os.Getenv("ANYPOINT_DLB_CERT"), os.Getenv("ANYPOINT_DLB_KEY"))
+}
@@ -0,0 +1,425 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"strings"
+)
+
+const (
+	assetOnDestroyDeprecate = "deprecate"
+	assetOnDestroyDelete    = "delete"
+)
+
+func resourceExchangeAsset() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceExchangeAssetCreate,
+		Read:          resourceExchangeAssetRead,
+		Update:        resourceExchangeAssetUpdate,
+		Delete:        resourceExchangeAssetDelete,
+		CustomizeDiff: resourceExchangeAssetCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importExchangeAsset,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group publishing the asset",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"asset_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The semantic version of the asset. Changing it replaces the published versions, unless it is set to published_version",
+				Required:    true,
+			},
+			"published_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The version actually published: version, bumped to the next patch version every time the content changes without version changing",
+				Computed:    true,
+			},
+			"published_versions": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Every version published by this resource, to which on_destroy applies",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"classifier": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{sdk.AssetClassifierRAML, sdk.AssetClassifierOAS, sdk.AssetClassifierHTTP, sdk.AssetClassifierCustom}, false),
+			},
+			"api_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The version of the API described by raml and oas assets. Example: v1",
+				Optional:    true,
+			},
+			"main": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The main file of the archive. Example: api.raml",
+				Optional:    true,
+			},
+			"file_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Path to the file or zip archive to publish. Not used by http assets",
+				Optional:    true,
+			},
+			"content_hash": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "SHA-256 of the published file. A change publishes a new version",
+				Computed:    true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"category": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "Categories defined in the business group and their values for this asset",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"on_destroy": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "deprecate keeps the version available to its current consumers, delete removes it",
+				Optional:     true,
+				Default:      assetOnDestroyDeprecate,
+				ValidateFunc: validation.StringInSlice([]string{assetOnDestroyDeprecate, assetOnDestroyDelete}, false),
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceExchangeAssetCreate(d *schema.ResourceData, conf interface{}) error {
+	ex := conf.(*Config).AnypointClient.Exchange
+	groupID := d.Get("group_id").(string)
+	assetID := d.Get("asset_id").(string)
+	version := d.Get("version").(string)
+
+	if err := publishExchangeAsset(d, ex, version); err != nil {
+		return err
+	}
+
+	d.SetId(strings.Join([]string{groupID, assetID, version}, "/"))
+	d.Set("published_version", version)
+	d.Set("published_versions", []string{version})
+
+	if err := updateExchangeAssetMetadata(d, ex, true); err != nil {
+		return err
+	}
+
+	return resourceExchangeAssetRead(d, conf)
+}
+
+func resourceExchangeAssetRead(d *schema.ResourceData, conf interface{}) error {
+	ex := conf.(*Config).AnypointClient.Exchange
+	groupID := d.Get("group_id").(string)
+	assetID := d.Get("asset_id").(string)
+	version := publishedExchangeAssetVersion(d)
+
+	asset, err := ex.GetAsset(groupID, assetID, version)
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Asset %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading asset %s : %s", d.Id(), err)
+	}
+
+	//A deprecated version is as good as destroyed: publishing it again fails, so it has to be bumped
+	if asset.Status == sdk.AssetStatusDeprecated {
+		log.Printf("Asset %s is deprecated. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", asset.Name)
+	d.Set("description", asset.Description)
+	d.Set("classifier", asset.Classifier)
+	d.Set("status", asset.Status)
+	d.Set("tags", asset.Labels)
+
+	categories := make([]interface{}, 0, len(asset.Categories))
+	for _, category := range asset.Categories {
+		categories = append(categories, map[string]interface{}{
+			"key":    category.Key,
+			"values": category.Values,
+		})
+	}
+
+	if err := d.Set("category", categories); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//resourceExchangeAssetUpdate publishes the bumped version planned by resourceExchangeAssetCustomizeDiff when
+//the content changed, since published versions are immutable. Otherwise it updates the metadata of the version
+func resourceExchangeAssetUpdate(d *schema.ResourceData, conf interface{}) error {
+	ex := conf.(*Config).AnypointClient.Exchange
+
+	if d.HasChange("published_version") {
+		version := d.Get("published_version").(string)
+
+		if err := publishExchangeAsset(d, ex, version); err != nil {
+			return err
+		}
+
+		//published_versions is unknown until the new version is published: start over from the state
+		o, _ := d.GetChange("published_versions")
+		versions := expandStringList(o.([]interface{}))
+		if len(versions) == 0 {
+			previous, _ := d.GetChange("published_version")
+			versions = []string{previous.(string)}
+		}
+
+		d.SetId(strings.Join([]string{d.Get("group_id").(string), d.Get("asset_id").(string), version}, "/"))
+		d.Set("published_versions", append(versions, version))
+
+		if err := updateExchangeAssetMetadata(d, ex, true); err != nil {
+			return err
+		}
+
+		return resourceExchangeAssetRead(d, conf)
+	}
+
+	if err := updateExchangeAssetMetadata(d, ex, false); err != nil {
+		return err
+	}
+
+	return resourceExchangeAssetRead(d, conf)
+}
+
+//resourceExchangeAssetDelete applies on_destroy to every version published by the resource, not only the last one
+func resourceExchangeAssetDelete(d *schema.ResourceData, conf interface{}) error {
+	ex := conf.(*Config).AnypointClient.Exchange
+	groupID := d.Get("group_id").(string)
+	assetID := d.Get("asset_id").(string)
+
+	for _, version := range publishedExchangeAssetVersions(d) {
+		var err error
+		if d.Get("on_destroy").(string) == assetOnDestroyDelete {
+			err = ex.DeleteAsset(groupID, assetID, version)
+		} else {
+			err = ex.DeprecateAsset(groupID, assetID, version)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//resourceExchangeAssetCustomizeDiff hashes the local file. Since a published version cannot be overwritten, new
+//content without a new version is published as the next patch version. Setting version to that bumped version
+//only catches the configuration up with what is published, any other version replaces the resource
+func resourceExchangeAssetCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	filePath, hasFile := d.GetOk("file_path")
+	classifier := d.Get("classifier").(string)
+
+	if !d.NewValueKnown("file_path") {
+		return d.SetNewComputed("content_hash")
+	}
+
+	if classifier != sdk.AssetClassifierHTTP && !hasFile && d.NewValueKnown("classifier") {
+		return fmt.Errorf("file_path is required for %s assets", classifier)
+	}
+
+	contentChanged := false
+	for _, attr := range []string{"name", "classifier", "api_version", "main", "file_path"} {
+		contentChanged = contentChanged || d.HasChange(attr)
+	}
+
+	if hasFile {
+		hash, err := fileSHA256(filePath.(string))
+		if err != nil {
+			return err
+		}
+
+		published := d.Get("content_hash").(string)
+		if hash != published {
+			if err := d.SetNew("content_hash", hash); err != nil {
+				return err
+			}
+
+			//Imported assets have no hash yet: the local file is trusted to be the one published
+			contentChanged = contentChanged || published != ""
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	published := d.Get("published_version").(string)
+	if published == "" {
+		oldVersion, _ := d.GetChange("version")
+		published = oldVersion.(string)
+	}
+
+	if version := d.Get("version").(string); d.HasChange("version") && version != published {
+		for _, v := range d.Get("published_versions").([]interface{}) {
+			if v.(string) == version {
+				return fmt.Errorf("version %s of asset %s was already published by this resource and cannot be published again", version, d.Id())
+			}
+		}
+
+		return d.ForceNew("version")
+	}
+
+	if !contentChanged {
+		return nil
+	}
+
+	next, err := nextPatchVersion(published)
+	if err != nil {
+		return fmt.Errorf("the content of asset %s changed but its version cannot be bumped : %s", d.Id(), err)
+	}
+
+	if err := d.SetNew("published_version", next); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("published_versions")
+}
+
+//importExchangeAsset imports asset versions using IDs with the format <group_id>/<asset_id>/<version>
+func importExchangeAsset(d *schema.ResourceData, conf interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("invalid import ID %q. Expected format is <group_id>/<asset_id>/<version>", d.Id())
+	}
+
+	d.Set("group_id", parts[0])
+	d.Set("asset_id", parts[1])
+	d.Set("version", parts[2])
+	d.Set("published_version", parts[2])
+	d.Set("published_versions", []string{parts[2]})
+	d.Set("on_destroy", assetOnDestroyDeprecate)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+//updateExchangeAssetMetadata sends the description, tags and categories that changed, or all of them when
+//the version has just been published
+func updateExchangeAssetMetadata(d *schema.ResourceData, ex *sdk.Exchange, all bool) error {
+	groupID := d.Get("group_id").(string)
+	assetID := d.Get("asset_id").(string)
+	version := publishedExchangeAssetVersion(d)
+
+	if all || d.HasChange("description") {
+		if err := ex.SetAssetDescription(groupID, assetID, version, d.Get("description").(string)); err != nil {
+			return err
+		}
+	}
+
+	if all || d.HasChange("tags") {
+		if err := ex.SetAssetTags(groupID, assetID, version, expandStringList(d.Get("tags").(*schema.Set).List())); err != nil {
+			return err
+		}
+	}
+
+	if !all && !d.HasChange("category") {
+		return nil
+	}
+
+	o, n := d.GetChange("category")
+	categories := map[string][]string{}
+
+	//Categories removed from the configuration are cleared with an empty list of values
+	for _, c := range o.(*schema.Set).List() {
+		categories[c.(map[string]interface{})["key"].(string)] = nil
+	}
+
+	for _, c := range n.(*schema.Set).List() {
+		category := c.(map[string]interface{})
+		categories[category["key"].(string)] = expandStringList(category["values"].([]interface{}))
+	}
+
+	for key, values := range categories {
+		if err := ex.SetAssetCategory(groupID, assetID, version, key, values); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func publishExchangeAsset(d *schema.ResourceData, ex *sdk.Exchange, version string) error {
+	_, err := ex.PublishAsset(sdk.ExchangeAsset{
+		GroupID:    d.Get("group_id").(string),
+		AssetID:    d.Get("asset_id").(string),
+		Version:    version,
+		Name:       d.Get("name").(string),
+		Classifier: d.Get("classifier").(string),
+		APIVersion: d.Get("api_version").(string),
+		Main:       d.Get("main").(string),
+		FilePath:   d.Get("file_path").(string),
+	})
+
+	return err
+}
+
+//publishedExchangeAssetVersion returns published_version, falling back to version for assets created before
+//versions were bumped automatically
+func publishedExchangeAssetVersion(d *schema.ResourceData) string {
+	if version := d.Get("published_version").(string); version != "" {
+		return version
+	}
+
+	return d.Get("version").(string)
+}
+
+//publishedExchangeAssetVersions returns published_versions, falling back to the version last published for
+//assets created before every published version was tracked
+func publishedExchangeAssetVersions(d *schema.ResourceData) []string {
+	versions := expandStringList(d.Get("published_versions").([]interface{}))
+	if len(versions) == 0 {
+		versions = []string{publishedExchangeAssetVersion(d)}
+	}
+
+	return versions
+}
@@ -0,0 +1,129 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const testAccExchangeAssetRAML = `#%%RAML 1.0
+title: Terraform acceptance test
+version: v1
+/%s:
+  get:
+`
+
+func TestAccExchangeAsset_raml(t *testing.T) {
+	var providers []*schema.Provider
+
+	assetID := fmt.Sprintf("test-asset-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	spec, err := ioutil.TempFile("", "api-*.raml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(spec.Name())
+
+	if _, err := fmt.Fprintf(spec, testAccExchangeAssetRAML, "orders"); err != nil {
+		t.Fatal(err)
+	}
+	spec.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckVars(t, "ANYPOINT_ORG_ID")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckExchangeAssetDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExchangeAssetConfig_raml(assetID, "1.0.0", spec.Name(), "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_exchange_asset.test", "status", sdk.AssetStatusPublished),
+					resource.TestCheckResourceAttrSet("anypoint_exchange_asset.test", "content_hash"),
+				),
+			},
+			{
+				Config: testAccExchangeAssetConfig_raml(assetID, "1.0.0", spec.Name(), "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_exchange_asset.test", "description", "second"),
+				),
+			},
+			{
+				PreConfig: func() {
+					ioutil.WriteFile(spec.Name(), []byte(fmt.Sprintf(testAccExchangeAssetRAML, "customers")), 0644)
+				},
+				Config: testAccExchangeAssetConfig_raml(assetID, "1.0.0", spec.Name(), "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_exchange_asset.test", "version", "1.0.0"),
+					resource.TestCheckResourceAttr("anypoint_exchange_asset.test", "published_version", "1.0.1"),
+				),
+			},
+			{
+				Config: testAccExchangeAssetConfig_raml(assetID, "1.0.1", spec.Name(), "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_exchange_asset.test", "published_version", "1.0.1"),
+					resource.TestCheckResourceAttr("anypoint_exchange_asset.test", "published_versions.#", "2"),
+				),
+			},
+			{
+				Config: testAccExchangeAssetConfig_raml(assetID, "1.1.0", spec.Name(), "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_exchange_asset.test", "published_version", "1.1.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckExchangeAssetDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	ex := provider.Meta().(*Config).AnypointClient.Exchange
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_exchange_asset" {
+			continue
+		}
+
+		versions, _ := strconv.Atoi(rs.Primary.Attributes["published_versions.#"])
+		for i := 0; i < versions; i++ {
+			version := rs.Primary.Attributes[fmt.Sprintf("published_versions.%d", i)]
+			asset, err := ex.GetAsset(rs.Primary.Attributes["group_id"], rs.Primary.Attributes["asset_id"], version)
+
+			if err == nil && asset.Status != sdk.AssetStatusDeprecated {
+				return fmt.Errorf("Found version %s of asset %s that is not deprecated", version, rs.Primary.ID)
+			}
+
+			if err != nil && !sdk.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccExchangeAssetConfig_raml(assetID, version, path, description string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_exchange_asset" "test" {
+			group_id    = "%s"
+			asset_id    = "%s"
+			version     = "%s"
+			name        = "%s"
+			classifier  = "raml"
+			api_version = "v1"
+			file_path   = "%s"
+			description = "%s"
+			tags        = ["terraform"]
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), assetID, version, assetID, strings.Replace(path, `\`, `/`, -1), description)
+}
@@ -0,0 +1,166 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceFlexGateway() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceFlexGatewayCreate,
+		Read:   resourceFlexGatewayRead,
+		Update: resourceFlexGatewayUpdate,
+		Delete: resourceFlexGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: importEnvScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"gateway_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The target_id of the API instances deployed to the gateway",
+				Computed:    true,
+			},
+			"registration_token": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The token passed to `flexctl registration create`",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"registration_config": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The contents of the registration.yaml file of the replicas",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replicas": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The replicas connected to the gateway",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_seen": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceFlexGatewayCreate(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+
+	gateway, err := rm.CreateFlexGateway(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("name").(string))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(gateway.ID)
+
+	return resourceFlexGatewayRead(d, conf)
+}
+
+func resourceFlexGatewayRead(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+
+	gateway, err := rm.GetFlexGateway(orgID, envID, d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Flex Gateway %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading Flex Gateway %s : %s", d.Id(), err)
+	}
+
+	registration, err := rm.GetFlexGatewayRegistration(orgID, envID, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("name", gateway.Name)
+	d.Set("gateway_id", gateway.ID)
+	d.Set("status", gateway.Status)
+	d.Set("registration_token", registration.Token)
+	d.Set("registration_config", registration.Configuration)
+
+	replicas := make([]interface{}, 0, len(gateway.Replicas))
+	for _, replica := range gateway.Replicas {
+		replicas = append(replicas, map[string]interface{}{
+			"id":        replica.ID,
+			"hostname":  replica.Hostname,
+			"status":    replica.Status,
+			"version":   replica.Version,
+			"last_seen": replica.LastSeen,
+		})
+	}
+
+	if err := d.Set("replicas", replicas); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceFlexGatewayUpdate(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+
+	if d.HasChange("name") {
+		if _, err := rm.RenameFlexGateway(d.Get("org_id").(string), d.Get("env_id").(string), d.Id(), d.Get("name").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceFlexGatewayRead(d, conf)
+}
+
+func resourceFlexGatewayDelete(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+
+	return rm.DeleteFlexGateway(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+}
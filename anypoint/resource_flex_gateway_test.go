@@ -0,0 +1,84 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"testing"
+)
+
+func TestAccFlexGateway_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	name := fmt.Sprintf("test-flex-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckFlexGatewayDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlexGatewayConfig_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("anypoint_flex_gateway.test", "gateway_id", "anypoint_flex_gateway.test", "id"),
+					resource.TestCheckResourceAttrSet("anypoint_flex_gateway.test", "registration_token"),
+					resource.TestCheckResourceAttr("anypoint_flex_gateway.test", "replicas.#", "0"),
+				),
+			},
+			{
+				Config: testAccFlexGatewayConfig_basic(name + "-renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_flex_gateway.test", "name", name+"-renamed"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_flex_gateway.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_flex_gateway.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckFlexGatewayDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	rm := provider.Meta().(*Config).AnypointClient.RuntimeManager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_flex_gateway" {
+			continue
+		}
+
+		_, err := rm.GetFlexGateway(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found Flex Gateway with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccFlexGatewayConfig_basic(name string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_flex_gateway" "test" {
+			org_id = "%s"
+			env_id = "%s"
+			name   = "%s"
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), name)
+}
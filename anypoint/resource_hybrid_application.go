@@ -0,0 +1,305 @@
+package anypoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const hybridStatusDeploying = "DEPLOYING"
+
+func resourceHybridApplication() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceHybridAppCreate,
+		Read:          resourceHybridAppRead,
+		Update:        resourceHybridAppUpdate,
+		Delete:        resourceHybridAppDelete,
+		CustomizeDiff: resourceHybridAppCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group the application belongs to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"env_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the environment the application is deployed to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The name of the application",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"target_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the server, server group or cluster to deploy to",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"artifact_path": &schema.Schema{
+				Type:          schema.TypeString,
+				Description:   "Path to the application jar to upload",
+				Optional:      true,
+				ConflictsWith: []string{"exchange"},
+			},
+			"artifact_hash": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "SHA-256 of the uploaded artifact. A change triggers a redeploy",
+				Computed:    true,
+			},
+			"exchange": &schema.Schema{
+				Type:          schema.TypeList,
+				Description:   "Exchange coordinates of the application to deploy",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"artifact_path"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"artifact_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"properties": &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Application properties",
+				Optional:    true,
+			},
+			"target_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Whether the target is a SERVER, SERVER_GROUP or CLUSTER",
+				Computed:    true,
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The last status reported by the runtime",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceHybridAppCreate(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+
+	app, err := rm.DeployHybridApplication(orgID, envID, getHybridDeploymentFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(app.ID))
+
+	if err = waitForHybridApplication(rm, orgID, envID, app.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourceHybridAppRead(d, conf)
+}
+
+func resourceHybridAppRead(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+
+	appID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid hybrid application ID %q : %s", d.Id(), err)
+	}
+
+	app, err := rm.GetHybridApplication(d.Get("org_id").(string), d.Get("env_id").(string), appID)
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Hybrid application %d not found. Removing it from the state", appID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading hybrid application %d : %s", appID, err)
+	}
+
+	d.Set("name", app.Artifact.Name)
+	d.Set("target_id", app.Target.ID)
+	d.Set("target_type", app.Target.Type)
+	d.Set("status", app.LastReportedStatus)
+
+	return nil
+}
+
+func resourceHybridAppUpdate(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+
+	appID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid hybrid application ID %q : %s", d.Id(), err)
+	}
+
+	if _, err = rm.RedeployHybridApplication(orgID, envID, appID, getHybridDeploymentFromData(d)); err != nil {
+		return err
+	}
+
+	if err = waitForHybridApplication(rm, orgID, envID, appID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourceHybridAppRead(d, conf)
+}
+
+func resourceHybridAppDelete(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+
+	appID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("invalid hybrid application ID %q : %s", d.Id(), err)
+	}
+
+	return rm.DeleteHybridApplication(d.Get("org_id").(string), d.Get("env_id").(string), appID)
+}
+
+//resourceHybridAppCustomizeDiff hashes the local artifact so that a new jar at the same path is planned
+//as a redeploy
+func resourceHybridAppCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	_, hasExchange := d.GetOk("exchange")
+	artifactPath, hasArtifact := d.GetOk("artifact_path")
+
+	if !d.NewValueKnown("artifact_path") {
+		return d.SetNewComputed("artifact_hash")
+	}
+
+	if hasArtifact == hasExchange {
+		return errors.New("exactly one of artifact_path or exchange must be set")
+	}
+
+	if !hasArtifact {
+		return nil
+	}
+
+	hash, err := fileSHA256(artifactPath.(string))
+	if err != nil {
+		return err
+	}
+
+	if hash != d.Get("artifact_hash").(string) {
+		return d.SetNew("artifact_hash", hash)
+	}
+
+	return nil
+}
+
+func getHybridDeploymentFromData(d *schema.ResourceData) sdk.HybridDeployment {
+	deployment := sdk.HybridDeployment{
+		ArtifactName: d.Get("name").(string),
+		TargetID:     d.Get("target_id").(int),
+		ArtifactPath: d.Get("artifact_path").(string),
+	}
+
+	if val, isSet := d.GetOk("exchange"); isSet {
+		exchange := val.([]interface{})[0].(map[string]interface{})
+		deployment.Source = &sdk.ApplicationSource{
+			Source:         sdk.ApplicationSourceExchange,
+			GroupID:        exchange["group_id"].(string),
+			ArtifactID:     exchange["artifact_id"].(string),
+			Version:        exchange["version"].(string),
+			OrganizationID: d.Get("org_id").(string),
+		}
+	}
+
+	//Properties removed from the configuration are cleared with an empty map
+	if val, isSet := d.GetOk("properties"); isSet || d.HasChange("properties") {
+		deployment.Properties = expandStringMap(val)
+	}
+
+	return deployment
+}
+
+//waitForHybridApplication polls the application until every server of the target reports STARTED
+func waitForHybridApplication(rm *sdk.RuntimeManager, orgID, envID string, appID int, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{hybridStatusDeploying},
+		Target:     []string{sdk.HybridStatusStarted},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			app, err := rm.GetHybridApplication(orgID, envID, appID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if len(app.ServerArtifacts) == 0 {
+				return app, hybridStatusDeploying, nil
+			}
+
+			started := 0
+			for _, artifact := range app.ServerArtifacts {
+				switch artifact.LastReportedStatus {
+				case sdk.HybridStatusStarted:
+					started++
+				case sdk.HybridStatusDeploymentFailed, sdk.HybridStatusFailed:
+					return nil, "", fmt.Errorf("deployment of application %d failed on server %d : %s", appID, artifact.ServerID, strings.TrimSpace(artifact.Message))
+				}
+			}
+
+			if started < len(app.ServerArtifacts) {
+				return app, hybridStatusDeploying, nil
+			}
+
+			return app, sdk.HybridStatusStarted, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error while waiting for hybrid application %d to start : %s", appID, err)
+	}
+
+	return nil
+}
+
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to open %s : %s", path, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("unable to read %s : %s", path, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
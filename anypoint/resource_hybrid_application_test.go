@@ -0,0 +1,82 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestAccHybridApplication_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	appName := fmt.Sprintf("test-hybrid-app-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+			testAccPreCheckVars(t, "ANYPOINT_HYBRID_TARGET_ID", "ANYPOINT_HYBRID_ARTIFACT")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckHybridApplicationDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHybridApplicationConfig_basic(appName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_hybrid_application.test", "name", appName),
+					resource.TestCheckResourceAttr("anypoint_hybrid_application.test", "status", sdk.HybridStatusStarted),
+					resource.TestCheckResourceAttrSet("anypoint_hybrid_application.test", "artifact_hash"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckHybridApplicationDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	rm := provider.Meta().(*Config).AnypointClient.RuntimeManager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_hybrid_application" {
+			continue
+		}
+
+		appID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = rm.GetHybridApplication(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], appID)
+
+		if err == nil {
+			return fmt.Errorf("Found hybrid application with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccHybridApplicationConfig_basic(appName string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_hybrid_application" "test" {
+			org_id        = "%s"
+			env_id        = "%s"
+			name          = "%s"
+			target_id     = %s
+			artifact_path = "%s"
+
+			properties {
+				"env" = "test"
+			}
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), appName, os.Getenv("ANYPOINT_HYBRID_TARGET_ID"), os.Getenv("ANYPOINT_HYBRID_ARTIFACT"))
+}
@@ -0,0 +1,226 @@
+package anypoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceKeystore() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceKeystoreCreate,
+		Read:          resourceKeystoreRead,
+		Update:        resourceKeystoreUpdate,
+		Delete:        resourceKeystoreDelete,
+		CustomizeDiff: resourceKeystoreCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importSecretGroupScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{sdk.StoreTypeJKS, sdk.StoreTypePKCS12, sdk.StoreTypeJCEKS, sdk.StoreTypePEM}, false),
+			},
+			"file_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Path to the keystore file. Required by JKS, PKCS12 and JCEKS keystores",
+				Optional:    true,
+			},
+			"certificate_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Path to the PEM certificate. Required by PEM keystores",
+				Optional:    true,
+			},
+			"key_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Path to the PEM private key. Required by PEM keystores",
+				Optional:    true,
+			},
+			"ca_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Path to the PEM chain of the certificate authorities",
+				Optional:    true,
+			},
+			"store_passphrase": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"key_passphrase": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"alias": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The alias of the key to use when the keystore holds several",
+				Optional:    true,
+			},
+			"content_hash": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "SHA-256 of the uploaded files. A change uploads them again",
+				Computed:    true,
+			},
+			"path": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The reference used by TLS contexts. Example: keystores/<id>",
+				Computed:    true,
+			},
+			"expiration_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeystoreCreate(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	id, err := sm.CreateKeystore(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), getKeystoreFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceKeystoreRead(d, conf)
+}
+
+//resourceKeystoreRead reads the metadata of the keystore. Files and passphrases cannot be read back,
+//so drift on the content is only detected through content_hash
+func resourceKeystoreRead(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	keystore, err := sm.GetKeystore(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Keystore %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading keystore %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", keystore.Name)
+	d.Set("type", keystore.Type)
+	d.Set("alias", keystore.Alias)
+	d.Set("path", keystore.Meta.Path)
+	d.Set("expiration_date", keystore.ExpirationDate)
+
+	return nil
+}
+
+//resourceKeystoreUpdate uploads the keystore again: the API only replaces keystores as a whole
+func resourceKeystoreUpdate(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	err := sm.UpdateKeystore(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), d.Id(), getKeystoreFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceKeystoreRead(d, conf)
+}
+
+func resourceKeystoreDelete(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	return sm.DeleteSecret(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), sdk.SecretTypeKeystore, d.Id())
+}
+
+//resourceKeystoreCustomizeDiff checks that the files required by the keystore type are set and hashes them
+func resourceKeystoreCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	required := []string{"file_path"}
+	if d.Get("type").(string) == sdk.StoreTypePEM {
+		required = []string{"certificate_path", "key_path"}
+	}
+
+	for _, attribute := range required {
+		if _, ok := d.GetOk(attribute); !ok && d.NewValueKnown(attribute) {
+			return fmt.Errorf("%s is required for %s keystores", attribute, d.Get("type"))
+		}
+	}
+
+	return setSecretContentHash(d, "file_path", "certificate_path", "key_path", "ca_path")
+}
+
+//setSecretContentHash plans a new content_hash when the content of the files referenced by the given
+//attributes differs from the one uploaded
+func setSecretContentHash(d *schema.ResourceDiff, attributes ...string) error {
+	h := sha256.New()
+
+	for _, attribute := range attributes {
+		if !d.NewValueKnown(attribute) {
+			return d.SetNewComputed("content_hash")
+		}
+
+		path := d.Get(attribute).(string)
+		if path == "" {
+			continue
+		}
+
+		hash, err := fileSHA256(path)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(h, "%s=%s\n", attribute, hash)
+	}
+
+	hash := hex.EncodeToString(h.Sum(nil))
+	if hash == d.Get("content_hash").(string) {
+		return nil
+	}
+
+	return d.SetNew("content_hash", hash)
+}
+
+func getKeystoreFromData(d *schema.ResourceData) sdk.Keystore {
+	return sdk.Keystore{
+		Name:            d.Get("name").(string),
+		Type:            d.Get("type").(string),
+		Alias:           d.Get("alias").(string),
+		StorePassphrase: d.Get("store_passphrase").(string),
+		KeyPassphrase:   d.Get("key_passphrase").(string),
+		FilePath:        d.Get("file_path").(string),
+		CertificatePath: d.Get("certificate_path").(string),
+		KeyPath:         d.Get("key_path").(string),
+		CAPath:          d.Get("ca_path").(string),
+	}
+}
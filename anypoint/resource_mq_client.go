@@ -0,0 +1,126 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceMQClient() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceMQClientCreate,
+		Read:          resourceMQClientRead,
+		Update:        resourceMQClientUpdate,
+		Delete:        resourceMQClientDelete,
+		CustomizeDiff: resourceMQClientCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importEnvScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The environment whose destinations the client can access",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"rotation_trigger": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Any value. Changing it regenerates client_secret. Example: a date or a counter",
+				Optional:    true,
+			},
+			"client_id": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceMQClientCreate(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	client, err := mq.CreateClient(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("name").(string))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(client.ClientID)
+
+	return resourceMQClientRead(d, conf)
+}
+
+func resourceMQClientRead(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	client, err := mq.GetClient(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("MQ client %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading MQ client %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", client.Name)
+	d.Set("client_id", client.ClientID)
+	d.Set("client_secret", client.ClientSecret)
+
+	return nil
+}
+
+func resourceMQClientUpdate(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+
+	if d.HasChange("name") {
+		if _, err := mq.RenameClient(orgID, envID, d.Id(), d.Get("name").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("rotation_trigger") {
+		if _, err := mq.RegenerateClientSecret(orgID, envID, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceMQClientRead(d, conf)
+}
+
+func resourceMQClientDelete(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	return mq.DeleteClient(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+}
+
+//resourceMQClientCustomizeDiff plans a new client_secret when the rotation trigger changes
+func resourceMQClientCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	if d.Id() != "" && d.HasChange("rotation_trigger") {
+		return d.SetNewComputed("client_secret")
+	}
+
+	return nil
+}
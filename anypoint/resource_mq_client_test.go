@@ -0,0 +1,92 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"testing"
+)
+
+func TestAccMQClient_rotation(t *testing.T) {
+	var providers []*schema.Provider
+	var secret string
+
+	clientName := fmt.Sprintf("test-client-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckMQClientDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMQClientConfig_rotation(clientName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("anypoint_mq_client.test", "client_id"),
+					func(s *terraform.State) error {
+						secret = s.RootModule().Resources["anypoint_mq_client.test"].Primary.Attributes["client_secret"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccMQClientConfig_rotation(clientName, "2"),
+				Check: func(s *terraform.State) error {
+					if s.RootModule().Resources["anypoint_mq_client.test"].Primary.Attributes["client_secret"] == secret {
+						return fmt.Errorf("client_secret was not regenerated")
+					}
+					return nil
+				},
+			},
+			{
+				ResourceName:            "anypoint_mq_client.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_trigger"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_mq_client.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckMQClientDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	mq := provider.Meta().(*Config).AnypointClient.AnypointMQ
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_mq_client" {
+			continue
+		}
+
+		_, err := mq.GetClient(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found MQ client with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccMQClientConfig_rotation(clientName, trigger string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_mq_client" "test" {
+			org_id           = "%s"
+			env_id           = "%s"
+			name             = "%s"
+			rotation_trigger = "%s"
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), clientName, trigger)
+}
@@ -0,0 +1,94 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceMQExchange() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceMQExchangeCreate,
+		Read:   resourceMQExchangeRead,
+		Delete: resourceMQExchangeDelete,
+		Importer: &schema.ResourceImporter{
+			State: importRegionScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The MQ region. Example: us-east-1",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"exchange_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"encrypted": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceMQExchangeCreate(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	exchange, err := mq.PutExchange(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string), sdk.MQExchange{
+		ExchangeID: d.Get("exchange_id").(string),
+		Encrypted:  d.Get("encrypted").(bool),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(exchange.ExchangeID)
+
+	return resourceMQExchangeRead(d, conf)
+}
+
+func resourceMQExchangeRead(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	exchange, err := mq.GetExchange(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Exchange %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading exchange %s : %s", d.Id(), err)
+	}
+
+	d.Set("exchange_id", exchange.ExchangeID)
+	d.Set("encrypted", exchange.Encrypted)
+
+	return nil
+}
+
+func resourceMQExchangeDelete(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	return mq.DeleteExchange(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string), d.Id())
+}
@@ -0,0 +1,266 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"strconv"
+)
+
+func resourceMQExchangeBinding() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceMQExchangeBindingCreate,
+		Read:   resourceMQExchangeBindingRead,
+		Update: resourceMQExchangeBindingUpdate,
+		Delete: resourceMQExchangeBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: importMQExchangeBinding,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The MQ region. Example: us-east-1",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"exchange_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"queue_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"rule": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Only messages matching every rule are routed to the queue. Without rules, every message published to the exchange is routed to the queue",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"property_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      sdk.MQPropertyTypeString,
+							ValidateFunc: validation.StringInSlice([]string{sdk.MQPropertyTypeString, sdk.MQPropertyTypeNumeric}, false),
+						},
+						"matcher_type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"EQ", "NE", "PREFIX", "GT", "GE", "LT", "LE", "ANY", "NONE"}, false),
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The value compared by the EQ, NE, PREFIX, GT, GE, LT and LE matchers",
+							Optional:    true,
+						},
+						"values": &schema.Schema{
+							Type:        schema.TypeList,
+							Description: "The values compared by the ANY and NONE matchers",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMQExchangeBindingCreate(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+	regionID := d.Get("region_id").(string)
+	exchangeID := d.Get("exchange_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	if _, err := mq.BindQueue(orgID, envID, regionID, exchangeID, queueID); err != nil {
+		return err
+	}
+
+	d.SetId(exchangeID + "/" + queueID)
+
+	rules, err := getMQRoutingRulesFromData(d)
+	if err != nil {
+		return err
+	}
+
+	if len(rules) > 0 {
+		if err := mq.SetRoutingRules(orgID, envID, regionID, exchangeID, queueID, rules); err != nil {
+			return err
+		}
+	}
+
+	return resourceMQExchangeBindingRead(d, conf)
+}
+
+func resourceMQExchangeBindingRead(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+	regionID := d.Get("region_id").(string)
+	exchangeID := d.Get("exchange_id").(string)
+	queueID := d.Get("queue_id").(string)
+
+	_, err := mq.GetBinding(orgID, envID, regionID, exchangeID, queueID)
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Binding %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading binding %s : %s", d.Id(), err)
+	}
+
+	rules, err := mq.GetRoutingRules(orgID, envID, regionID, exchangeID, queueID)
+
+	if err != nil && !sdk.IsNotFound(err) {
+		return fmt.Errorf("error while reading the routing rules of binding %s : %s", d.Id(), err)
+	}
+
+	if err := d.Set("rule", flattenMQRoutingRules(rules)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceMQExchangeBindingUpdate(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	rules, err := getMQRoutingRulesFromData(d)
+	if err != nil {
+		return err
+	}
+
+	err = mq.SetRoutingRules(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string),
+		d.Get("exchange_id").(string), d.Get("queue_id").(string), rules)
+
+	if err != nil {
+		return err
+	}
+
+	return resourceMQExchangeBindingRead(d, conf)
+}
+
+func resourceMQExchangeBindingDelete(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	return mq.UnbindQueue(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string),
+		d.Get("exchange_id").(string), d.Get("queue_id").(string))
+}
+
+//importMQExchangeBinding imports bindings using IDs with the format [<org_id>/]<env_id>/<region_id>/<exchange_id>/<queue_id>
+func importMQExchangeBinding(d *schema.ResourceData, conf interface{}) ([]*schema.ResourceData, error) {
+	orgID, envID, ids, err := parseEnvScopedImportID(d.Id(), conf, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("org_id", orgID)
+	d.Set("env_id", envID)
+	d.Set("region_id", ids[0])
+	d.Set("exchange_id", ids[1])
+	d.Set("queue_id", ids[2])
+	d.SetId(ids[1] + "/" + ids[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getMQRoutingRulesFromData(d *schema.ResourceData) ([]sdk.MQRoutingRule, error) {
+	rules := []sdk.MQRoutingRule{}
+
+	for i, r := range d.Get("rule").([]interface{}) {
+		rule := r.(map[string]interface{})
+		routingRule := sdk.MQRoutingRule{
+			PropertyName: rule["property_name"].(string),
+			PropertyType: rule["property_type"].(string),
+			MatcherType:  rule["matcher_type"].(string),
+		}
+
+		values := expandStringList(rule["values"].([]interface{}))
+		if routingRule.MatcherType == "ANY" || routingRule.MatcherType == "NONE" {
+			if len(values) == 0 {
+				return nil, fmt.Errorf("rule %d: the %s matcher requires values", i, routingRule.MatcherType)
+			}
+			routingRule.Value = values
+			rules = append(rules, routingRule)
+			continue
+		}
+
+		value := rule["value"].(string)
+		if value == "" || len(values) > 0 {
+			return nil, fmt.Errorf("rule %d: the %s matcher requires a single value", i, routingRule.MatcherType)
+		}
+
+		routingRule.Value = value
+		if routingRule.PropertyType == sdk.MQPropertyTypeNumeric {
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: %q is not a number", i, value)
+			}
+			routingRule.Value = number
+		}
+
+		rules = append(rules, routingRule)
+	}
+
+	return rules, nil
+}
+
+func flattenMQRoutingRules(rules []sdk.MQRoutingRule) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		flattened := map[string]interface{}{
+			"property_name": rule.PropertyName,
+			"property_type": rule.PropertyType,
+			"matcher_type":  rule.MatcherType,
+		}
+
+		switch value := rule.Value.(type) {
+		case []interface{}:
+			values := make([]string, 0, len(value))
+			for _, v := range value {
+				values = append(values, formatMQRuleValue(v))
+			}
+			flattened["values"] = values
+		default:
+			flattened["value"] = formatMQRuleValue(value)
+		}
+
+		result = append(result, flattened)
+	}
+
+	return result
+}
+
+func formatMQRuleValue(v interface{}) string {
+	if number, ok := v.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,177 @@
+package anypoint
+
+import (
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceMQQueue() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceMQQueueCreate,
+		Read:          resourceMQQueueRead,
+		Update:        resourceMQQueueUpdate,
+		Delete:        resourceMQQueueDelete,
+		CustomizeDiff: resourceMQQueueCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importRegionScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The MQ region. Example: us-east-1",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"queue_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"default_ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "How long messages are kept, in milliseconds. Defaults to 7 days",
+				Optional:     true,
+				Default:      604800000,
+				ValidateFunc: validation.IntBetween(60000, 1209600000),
+			},
+			"default_lock_ttl": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "How long a message stays locked for its consumer, in milliseconds",
+				Optional:     true,
+				Default:      120000,
+				ValidateFunc: validation.IntBetween(1000, 43200000),
+			},
+			"default_delivery_delay": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "Delay before new messages can be consumed, in seconds",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 900),
+			},
+			"dead_letter_queue_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The queue receiving the messages that could not be delivered max_deliveries times",
+				Optional:    true,
+			},
+			"max_deliveries": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 1000),
+			},
+			"fifo": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"encrypted": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceMQQueueCreate(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	queue, err := mq.PutQueue(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string), getMQQueueFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(queue.QueueID)
+
+	return resourceMQQueueRead(d, conf)
+}
+
+func resourceMQQueueRead(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	queue, err := mq.GetQueue(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Queue %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading queue %s : %s", d.Id(), err)
+	}
+
+	d.Set("queue_id", queue.QueueID)
+	d.Set("default_ttl", queue.DefaultTTL)
+	d.Set("default_lock_ttl", queue.DefaultLockTTL)
+	d.Set("default_delivery_delay", queue.DefaultDeliveryDelay)
+	d.Set("dead_letter_queue_id", queue.DeadLetterQueueID)
+	d.Set("max_deliveries", queue.MaxDeliveries)
+	d.Set("fifo", queue.Fifo)
+	d.Set("encrypted", queue.Encrypted)
+
+	return nil
+}
+
+func resourceMQQueueUpdate(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	if _, err := mq.PutQueue(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string), getMQQueueFromData(d)); err != nil {
+		return err
+	}
+
+	return resourceMQQueueRead(d, conf)
+}
+
+func resourceMQQueueDelete(d *schema.ResourceData, conf interface{}) error {
+	mq := conf.(*Config).AnypointClient.AnypointMQ
+
+	return mq.DeleteQueue(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string), d.Id())
+}
+
+//resourceMQQueueCustomizeDiff checks that the dead letter queue and the max deliveries are set together
+func resourceMQQueueCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	_, hasDLQ := d.GetOk("dead_letter_queue_id")
+	_, hasMaxDeliveries := d.GetOk("max_deliveries")
+
+	if !d.NewValueKnown("dead_letter_queue_id") || !d.NewValueKnown("max_deliveries") {
+		return nil
+	}
+
+	if hasDLQ != hasMaxDeliveries {
+		return errors.New("dead_letter_queue_id and max_deliveries must be set together")
+	}
+
+	return nil
+}
+
+func getMQQueueFromData(d *schema.ResourceData) sdk.MQQueue {
+	return sdk.MQQueue{
+		QueueID:              d.Get("queue_id").(string),
+		DefaultTTL:           d.Get("default_ttl").(int),
+		DefaultLockTTL:       d.Get("default_lock_ttl").(int),
+		DefaultDeliveryDelay: d.Get("default_delivery_delay").(int),
+		DeadLetterQueueID:    d.Get("dead_letter_queue_id").(string),
+		MaxDeliveries:        d.Get("max_deliveries").(int),
+		Fifo:                 d.Get("fifo").(bool),
+		Encrypted:            d.Get("encrypted").(bool),
+	}
+}
@@ -0,0 +1,145 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"testing"
+)
+
+func TestAccMQQueue_binding(t *testing.T) {
+	var providers []*schema.Provider
+
+	suffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckMQQueueDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMQQueueConfig_binding(suffix, 3, `
+					rule {
+						property_name = "country"
+						matcher_type  = "EQ"
+						value         = "NZ"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_mq_queue.test", "max_deliveries", "3"),
+					resource.TestCheckResourceAttr("anypoint_mq_exchange_binding.test", "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccMQQueueConfig_binding(suffix, 5, `
+					rule {
+						property_name = "country"
+						matcher_type  = "ANY"
+						values        = ["NZ", "AU"]
+					}
+
+					rule {
+						property_name = "amount"
+						property_type = "NUMERIC"
+						matcher_type  = "GT"
+						value         = "100"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_mq_queue.test", "max_deliveries", "5"),
+					resource.TestCheckResourceAttr("anypoint_mq_exchange_binding.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("anypoint_mq_exchange_binding.test", "rule.1.value", "100"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_mq_queue.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_mq_queue.test"]
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.Attributes["region_id"], rs.Primary.ID), nil
+				},
+			},
+			{
+				ResourceName:      "anypoint_mq_exchange_binding.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_mq_exchange_binding.test"]
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.Attributes["region_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckMQQueueDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	mq := provider.Meta().(*Config).AnypointClient.AnypointMQ
+
+	for _, rs := range s.RootModule().Resources {
+		var err error
+
+		switch rs.Type {
+		case "anypoint_mq_queue":
+			_, err = mq.GetQueue(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], rs.Primary.Attributes["region_id"], rs.Primary.ID)
+		case "anypoint_mq_exchange":
+			_, err = mq.GetExchange(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], rs.Primary.Attributes["region_id"], rs.Primary.ID)
+		default:
+			continue
+		}
+
+		if err == nil {
+			return fmt.Errorf("Found %s with ID %s", rs.Type, rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccMQQueueConfig_binding(suffix string, maxDeliveries int, rules string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_mq_queue" "dlq" {
+			org_id    = "%s"
+			env_id    = "%s"
+			region_id = "us-east-1"
+			queue_id  = "test-dlq-%s"
+		}
+
+		resource "anypoint_mq_queue" "test" {
+			org_id               = "${anypoint_mq_queue.dlq.org_id}"
+			env_id               = "${anypoint_mq_queue.dlq.env_id}"
+			region_id            = "${anypoint_mq_queue.dlq.region_id}"
+			queue_id             = "test-queue-%s"
+			default_ttl          = 86400000
+			dead_letter_queue_id = "${anypoint_mq_queue.dlq.queue_id}"
+			max_deliveries       = %d
+		}
+
+		resource "anypoint_mq_exchange" "test" {
+			org_id      = "${anypoint_mq_queue.dlq.org_id}"
+			env_id      = "${anypoint_mq_queue.dlq.env_id}"
+			region_id   = "${anypoint_mq_queue.dlq.region_id}"
+			exchange_id = "test-exchange-%s"
+		}
+
+		resource "anypoint_mq_exchange_binding" "test" {
+			org_id      = "${anypoint_mq_exchange.test.org_id}"
+			env_id      = "${anypoint_mq_exchange.test.env_id}"
+			region_id   = "${anypoint_mq_exchange.test.region_id}"
+			exchange_id = "${anypoint_mq_exchange.test.exchange_id}"
+			queue_id    = "${anypoint_mq_queue.test.queue_id}"
+			%s
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), suffix, suffix, maxDeliveries, suffix, rules)
+}
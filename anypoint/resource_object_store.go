@@ -0,0 +1,136 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceObjectStore() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceObjectStoreCreate,
+		Read:   resourceObjectStoreRead,
+		Update: resourceObjectStoreUpdate,
+		Delete: resourceObjectStoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: importRegionScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The Object Store region. Example: us-east-1",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"store_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"default_ttl_seconds": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "How long keys written without a TTL are kept, in seconds. Defaults to 30 days",
+				Optional:     true,
+				Default:      2592000,
+				ValidateFunc: validation.IntBetween(1, 2592000),
+			},
+			"persistent": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"partitions": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The partitions holding at least one key",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceObjectStoreCreate(d *schema.ResourceData, conf interface{}) error {
+	objectStore := conf.(*Config).AnypointClient.ObjectStore
+
+	store, err := objectStore.PutStore(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string), getObjectStoreFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(store.StoreID)
+
+	return resourceObjectStoreRead(d, conf)
+}
+
+func resourceObjectStoreRead(d *schema.ResourceData, conf interface{}) error {
+	objectStore := conf.(*Config).AnypointClient.ObjectStore
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+	regionID := d.Get("region_id").(string)
+
+	store, err := objectStore.GetStore(orgID, envID, regionID, d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Store %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading store %s : %s", d.Id(), err)
+	}
+
+	partitions, err := objectStore.GetPartitions(orgID, envID, regionID, d.Id())
+	if err != nil {
+		return err
+	}
+
+	d.Set("store_id", store.StoreID)
+	d.Set("default_ttl_seconds", store.DefaultTTLSeconds)
+	d.Set("persistent", store.Persistent)
+
+	if err := d.Set("partitions", partitions); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceObjectStoreUpdate(d *schema.ResourceData, conf interface{}) error {
+	objectStore := conf.(*Config).AnypointClient.ObjectStore
+
+	if _, err := objectStore.PutStore(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string), getObjectStoreFromData(d)); err != nil {
+		return err
+	}
+
+	return resourceObjectStoreRead(d, conf)
+}
+
+func resourceObjectStoreDelete(d *schema.ResourceData, conf interface{}) error {
+	objectStore := conf.(*Config).AnypointClient.ObjectStore
+
+	return objectStore.DeleteStore(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string), d.Id())
+}
+
+func getObjectStoreFromData(d *schema.ResourceData) sdk.ObjectStoreStore {
+	return sdk.ObjectStoreStore{
+		StoreID:           d.Get("store_id").(string),
+		DefaultTTLSeconds: d.Get("default_ttl_seconds").(int),
+		Persistent:        d.Get("persistent").(bool),
+	}
+}
@@ -0,0 +1,200 @@
+package anypoint
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceObjectStoreKey() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceObjectStoreKeyCreate,
+		Read:          resourceObjectStoreKeyRead,
+		Update:        resourceObjectStoreKeyCreate,
+		Delete:        resourceObjectStoreKeyDelete,
+		CustomizeDiff: resourceObjectStoreKeyCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importObjectStoreKey,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The Object Store region. Example: us-east-1",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"store_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"partition_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The partition holding the key. Mule apps write to the default partition unless told otherwise",
+				Optional:    true,
+				Default:     "default",
+				ForceNew:    true,
+			},
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": &schema.Schema{
+				Type:          schema.TypeString,
+				Description:   "The value of the key. Use sensitive_value instead to keep it out of the plan output",
+				Optional:      true,
+				ConflictsWith: []string{"sensitive_value"},
+			},
+			"sensitive_value": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"value"},
+			},
+			"binary": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the value is base64 encoded binary content. Example: base64encode(file(\"keystore.jks\"))",
+				Optional:    true,
+				Default:     false,
+			},
+			"ttl_seconds": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "How long the key is kept, in seconds. Defaults to the default TTL of the store",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 2592000),
+			},
+		},
+	}
+}
+
+//resourceObjectStoreKeyCreate writes the key. Keys are overwritten in place, so it is also used for updates
+func resourceObjectStoreKeyCreate(d *schema.ResourceData, conf interface{}) error {
+	objectStore := conf.(*Config).AnypointClient.ObjectStore
+
+	key, err := objectStore.PutKey(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string),
+		d.Get("store_id").(string), d.Get("partition_id").(string), getObjectStoreKeyFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(key.KeyID)
+
+	return resourceObjectStoreKeyRead(d, conf)
+}
+
+func resourceObjectStoreKeyRead(d *schema.ResourceData, conf interface{}) error {
+	objectStore := conf.(*Config).AnypointClient.ObjectStore
+
+	key, err := objectStore.GetKey(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string),
+		d.Get("store_id").(string), d.Get("partition_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Key %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading key %s : %s", d.Id(), err)
+	}
+
+	value := key.StringValue
+	if key.ValueType == sdk.ObjectStoreValueBinary {
+		value = key.BinaryValue
+	}
+
+	//Imported keys have neither attribute set yet and are read as sensitive so that their values are not
+	//printed until the configuration says otherwise
+	if d.Get("value").(string) != "" {
+		d.Set("value", value)
+	} else {
+		d.Set("sensitive_value", value)
+	}
+
+	d.Set("key", key.KeyID)
+	d.Set("binary", key.ValueType == sdk.ObjectStoreValueBinary)
+	d.Set("ttl_seconds", key.TTLSeconds)
+
+	return nil
+}
+
+func resourceObjectStoreKeyDelete(d *schema.ResourceData, conf interface{}) error {
+	objectStore := conf.(*Config).AnypointClient.ObjectStore
+
+	return objectStore.DeleteKey(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("region_id").(string),
+		d.Get("store_id").(string), d.Get("partition_id").(string), d.Id())
+}
+
+//resourceObjectStoreKeyCustomizeDiff checks that exactly one value is set and that binary values are base64
+func resourceObjectStoreKeyCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	if !d.NewValueKnown("value") || !d.NewValueKnown("sensitive_value") {
+		return nil
+	}
+
+	value := d.Get("value").(string) + d.Get("sensitive_value").(string)
+	if value == "" {
+		return errors.New("one of value or sensitive_value must be set")
+	}
+
+	if d.Get("binary").(bool) {
+		if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+			return fmt.Errorf("binary values must be base64 encoded : %s", err)
+		}
+	}
+
+	return nil
+}
+
+//importObjectStoreKey imports keys using IDs with the format [<org_id>/]<env_id>/<region_id>/<store_id>/<partition_id>/<key>
+func importObjectStoreKey(d *schema.ResourceData, conf interface{}) ([]*schema.ResourceData, error) {
+	orgID, envID, ids, err := parseEnvScopedImportID(d.Id(), conf, 4)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("org_id", orgID)
+	d.Set("env_id", envID)
+	d.Set("region_id", ids[0])
+	d.Set("store_id", ids[1])
+	d.Set("partition_id", ids[2])
+	d.SetId(ids[3])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getObjectStoreKeyFromData(d *schema.ResourceData) sdk.ObjectStoreKey {
+	key := sdk.ObjectStoreKey{
+		KeyID:      d.Get("key").(string),
+		ValueType:  sdk.ObjectStoreValueString,
+		TTLSeconds: d.Get("ttl_seconds").(int),
+	}
+
+	value := d.Get("value").(string) + d.Get("sensitive_value").(string)
+
+	if d.Get("binary").(bool) {
+		key.ValueType = sdk.ObjectStoreValueBinary
+		key.BinaryValue = value
+	} else {
+		key.StringValue = value
+	}
+
+	return key
+}
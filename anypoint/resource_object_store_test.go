@@ -0,0 +1,119 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"testing"
+)
+
+func TestAccObjectStore_keys(t *testing.T) {
+	var providers []*schema.Provider
+
+	suffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckObjectStoreDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectStoreConfig_keys(suffix, "value", "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_object_store.test", "default_ttl_seconds", "3600"),
+					resource.TestCheckResourceAttr("anypoint_object_store_key.plain", "value", "first"),
+					resource.TestCheckResourceAttr("anypoint_object_store_key.binary", "binary", "true"),
+				),
+			},
+			{
+				Config: testAccObjectStoreConfig_keys(suffix, "sensitive_value", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_object_store_key.plain", "value", ""),
+					resource.TestCheckResourceAttr("anypoint_object_store_key.plain", "sensitive_value", "second"),
+					resource.TestCheckResourceAttr("anypoint_object_store.test", "partitions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_object_store.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_object_store.test"]
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.Attributes["region_id"], rs.Primary.ID), nil
+				},
+			},
+			{
+				ResourceName:      "anypoint_object_store_key.plain",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_object_store_key.plain"]
+					return fmt.Sprintf("%s/%s/%s/%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.Attributes["region_id"],
+						rs.Primary.Attributes["store_id"], rs.Primary.Attributes["partition_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStoreDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	objectStore := provider.Meta().(*Config).AnypointClient.ObjectStore
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_object_store" {
+			continue
+		}
+
+		_, err := objectStore.GetStore(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], rs.Primary.Attributes["region_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found store with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccObjectStoreConfig_keys(suffix, valueAttribute, value string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_object_store" "test" {
+			org_id              = "%s"
+			env_id              = "%s"
+			region_id           = "us-east-1"
+			store_id            = "test-store-%s"
+			default_ttl_seconds = 3600
+		}
+
+		resource "anypoint_object_store_key" "plain" {
+			org_id    = "${anypoint_object_store.test.org_id}"
+			env_id    = "${anypoint_object_store.test.env_id}"
+			region_id = "${anypoint_object_store.test.region_id}"
+			store_id  = "${anypoint_object_store.test.store_id}"
+			key       = "greeting"
+			%s        = "%s"
+		}
+
+		resource "anypoint_object_store_key" "binary" {
+			org_id       = "${anypoint_object_store.test.org_id}"
+			env_id       = "${anypoint_object_store.test.env_id}"
+			region_id    = "${anypoint_object_store.test.region_id}"
+			store_id     = "${anypoint_object_store.test.store_id}"
+			partition_id = "certificates"
+			key          = "blob"
+			value        = "${base64encode("binary content")}"
+			binary       = true
+			ttl_seconds  = 600
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), suffix, valueAttribute, value)
+}
@@ -0,0 +1,138 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"time"
+)
+
+func resourcePrivateSpace() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourcePrivateSpaceCreate,
+		Read:   resourcePrivateSpaceRead,
+		Update: resourcePrivateSpaceUpdate,
+		Delete: resourcePrivateSpaceDelete,
+		Importer: &schema.ResourceImporter{
+			State: importOrgScopedResource,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the private space",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The region of the private space. Example: us-east-1",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePrivateSpaceCreate(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+	orgID := d.Get("org_id").(string)
+
+	space, err := rtf.CreatePrivateSpace(orgID, d.Get("name").(string), d.Get("region").(string))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(space.ID)
+
+	if err = waitForPrivateSpace(rtf, orgID, space.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	return resourcePrivateSpaceRead(d, conf)
+}
+
+func resourcePrivateSpaceRead(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+
+	space, err := rtf.GetPrivateSpace(d.Get("org_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Private space %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading private space %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", space.Name)
+	d.Set("region", space.Region)
+	d.Set("status", space.Status)
+
+	return nil
+}
+
+func resourcePrivateSpaceUpdate(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+
+	if d.HasChange("name") {
+		if _, err := rtf.RenamePrivateSpace(d.Get("org_id").(string), d.Id(), d.Get("name").(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourcePrivateSpaceRead(d, conf)
+}
+
+func resourcePrivateSpaceDelete(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+
+	return rtf.DeletePrivateSpace(d.Get("org_id").(string), d.Id())
+}
+
+//waitForPrivateSpace polls the private space until the platform has finished provisioning it
+func waitForPrivateSpace(rtf *sdk.RuntimeFabric, orgID, spaceID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{sdk.PrivateSpaceStatusCreating, sdk.PrivateSpaceStatusUpdating},
+		Target:     []string{sdk.PrivateSpaceStatusActive},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			space, err := rtf.GetPrivateSpace(orgID, spaceID)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if space.Status == sdk.PrivateSpaceStatusFailed {
+				return nil, "", fmt.Errorf("provisioning of private space %s failed", spaceID)
+			}
+
+			return space, space.Status, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error while waiting for private space %s to become %s : %s", spaceID, sdk.PrivateSpaceStatusActive, err)
+	}
+
+	return nil
+}
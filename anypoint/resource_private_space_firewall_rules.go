@@ -0,0 +1,169 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"time"
+)
+
+func resourcePrivateSpaceFirewallRules() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourcePrivateSpaceFirewallRulesCreate,
+		Read:   resourcePrivateSpaceFirewallRulesRead,
+		Update: resourcePrivateSpaceFirewallRulesUpdate,
+		Delete: resourcePrivateSpaceFirewallRulesDelete,
+		Importer: &schema.ResourceImporter{
+			State: importOrgScopedResource,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the private space",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"private_space_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Reference anypoint_private_space_network.private_space_id so that rules are applied once the network exists",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"rule": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "The complete set of firewall rules. Rules added outside Terraform are removed",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"inbound", "outbound"}, false),
+						},
+						"cidr_block": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.CIDRNetwork(0, 32),
+						},
+						"protocol": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "icmp"}, false),
+						},
+						"from_port": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"to_port": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePrivateSpaceFirewallRulesCreate(d *schema.ResourceData, conf interface{}) error {
+	d.SetId(d.Get("private_space_id").(string))
+
+	if err := setPrivateSpaceFirewallRules(d, conf, expandFirewallRules(d.Get("rule").(*schema.Set)), d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourcePrivateSpaceFirewallRulesRead(d, conf)
+}
+
+func resourcePrivateSpaceFirewallRulesRead(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+
+	space, err := rtf.GetPrivateSpace(d.Get("org_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Private space %s not found. Removing its firewall rules from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading firewall rules of private space %s : %s", d.Id(), err)
+	}
+
+	d.Set("private_space_id", space.ID)
+
+	if err := d.Set("rule", flattenFirewallRules(space.FirewallRules)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourcePrivateSpaceFirewallRulesUpdate(d *schema.ResourceData, conf interface{}) error {
+	if err := setPrivateSpaceFirewallRules(d, conf, expandFirewallRules(d.Get("rule").(*schema.Set)), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourcePrivateSpaceFirewallRulesRead(d, conf)
+}
+
+func resourcePrivateSpaceFirewallRulesDelete(d *schema.ResourceData, conf interface{}) error {
+	return setPrivateSpaceFirewallRules(d, conf, nil, d.Timeout(schema.TimeoutDelete))
+}
+
+func setPrivateSpaceFirewallRules(d *schema.ResourceData, conf interface{}, rules []sdk.FirewallRule, timeout time.Duration) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+	orgID := d.Get("org_id").(string)
+
+	if _, err := rtf.SetPrivateSpaceFirewallRules(orgID, d.Id(), rules); err != nil {
+		return err
+	}
+
+	return waitForPrivateSpace(rtf, orgID, d.Id(), timeout)
+}
+
+func expandFirewallRules(set *schema.Set) []sdk.FirewallRule {
+	rules := make([]sdk.FirewallRule, 0, set.Len())
+
+	for _, r := range set.List() {
+		rule := r.(map[string]interface{})
+		rules = append(rules, sdk.FirewallRule{
+			Type:      rule["type"].(string),
+			CidrBlock: rule["cidr_block"].(string),
+			Protocol:  rule["protocol"].(string),
+			FromPort:  rule["from_port"].(int),
+			ToPort:    rule["to_port"].(int),
+		})
+	}
+
+	return rules
+}
+
+func flattenFirewallRules(rules []sdk.FirewallRule) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"type":       rule.Type,
+			"cidr_block": rule.CidrBlock,
+			"protocol":   rule.Protocol,
+			"from_port":  rule.FromPort,
+			"to_port":    rule.ToPort,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,144 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"time"
+)
+
+func resourcePrivateSpaceNetwork() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourcePrivateSpaceNetworkCreate,
+		Read:   resourcePrivateSpaceNetworkRead,
+		Update: resourcePrivateSpaceNetworkUpdate,
+		Delete: resourcePrivateSpaceNetworkDelete,
+		Importer: &schema.ResourceImporter{
+			State: importOrgScopedResource,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the private space",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"private_space_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr_block": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The CIDR block of the private space network. It cannot be changed once provisioned",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.CIDRNetwork(16, 24),
+			},
+			"reserved_cidrs": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "CIDRs of the connected networks that must not overlap with the private space",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.CIDRNetwork(0, 32),
+				},
+			},
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"inbound_static_ips": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"outbound_static_ips": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"dns_target": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePrivateSpaceNetworkCreate(d *schema.ResourceData, conf interface{}) error {
+	d.SetId(d.Get("private_space_id").(string))
+
+	if err := configurePrivateSpaceNetwork(d, conf, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourcePrivateSpaceNetworkRead(d, conf)
+}
+
+func resourcePrivateSpaceNetworkRead(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+
+	space, err := rtf.GetPrivateSpace(d.Get("org_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) || (err == nil && space.Network == nil) {
+		log.Printf("Network of private space %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading network of private space %s : %s", d.Id(), err)
+	}
+
+	d.Set("private_space_id", space.ID)
+	d.Set("cidr_block", space.Network.CidrBlock)
+	d.Set("reserved_cidrs", space.Network.ReservedCidrs)
+	d.Set("region", space.Network.Region)
+	d.Set("inbound_static_ips", space.Network.InboundStaticIPs)
+	d.Set("outbound_static_ips", space.Network.OutboundStaticIPs)
+	d.Set("dns_target", space.Network.DNSTarget)
+
+	return nil
+}
+
+func resourcePrivateSpaceNetworkUpdate(d *schema.ResourceData, conf interface{}) error {
+	if err := configurePrivateSpaceNetwork(d, conf, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+
+	return resourcePrivateSpaceNetworkRead(d, conf)
+}
+
+//resourcePrivateSpaceNetworkDelete only forgets the network: the platform releases it together with
+//the private space
+func resourcePrivateSpaceNetworkDelete(d *schema.ResourceData, conf interface{}) error {
+	log.Printf("The network of private space %s is released when the private space is deleted", d.Id())
+	return nil
+}
+
+func configurePrivateSpaceNetwork(d *schema.ResourceData, conf interface{}, timeout time.Duration) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+	orgID := d.Get("org_id").(string)
+
+	network := sdk.PrivateSpaceNetwork{
+		CidrBlock:     d.Get("cidr_block").(string),
+		ReservedCidrs: expandStringList(d.Get("reserved_cidrs").([]interface{})),
+	}
+
+	if _, err := rtf.ConfigurePrivateSpaceNetwork(orgID, d.Id(), network); err != nil {
+		return err
+	}
+
+	return waitForPrivateSpace(rtf, orgID, d.Id(), timeout)
+}
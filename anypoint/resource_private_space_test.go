@@ -0,0 +1,122 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"testing"
+)
+
+func TestAccPrivateSpace_network(t *testing.T) {
+	var providers []*schema.Provider
+
+	spaceName := fmt.Sprintf("test-ps-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckVars(t, "ANYPOINT_ORG_ID")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckPrivateSpaceDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivateSpaceConfig_network(spaceName, `
+					rule {
+						type       = "inbound"
+						cidr_block = "0.0.0.0/0"
+						protocol   = "tcp"
+						from_port  = 443
+						to_port    = 443
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_private_space.test", "status", sdk.PrivateSpaceStatusActive),
+					resource.TestCheckResourceAttr("anypoint_private_space_network.test", "cidr_block", "10.0.0.0/22"),
+					resource.TestCheckResourceAttr("anypoint_private_space_firewall_rules.test", "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccPrivateSpaceConfig_network(spaceName, `
+					rule {
+						type       = "inbound"
+						cidr_block = "0.0.0.0/0"
+						protocol   = "tcp"
+						from_port  = 443
+						to_port    = 443
+					}
+
+					rule {
+						type       = "outbound"
+						cidr_block = "192.168.0.0/16"
+						protocol   = "tcp"
+						from_port  = 0
+						to_port    = 65535
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_private_space_firewall_rules.test", "rule.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_private_space_firewall_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_private_space_firewall_rules.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["org_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckPrivateSpaceDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	rtf := provider.Meta().(*Config).AnypointClient.RuntimeFabric
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_private_space" {
+			continue
+		}
+
+		_, err := rtf.GetPrivateSpace(rs.Primary.Attributes["org_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found private space with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccPrivateSpaceConfig_network(spaceName, rules string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_private_space" "test" {
+			org_id = "%s"
+			name   = "%s"
+			region = "us-east-1"
+		}
+
+		resource "anypoint_private_space_network" "test" {
+			org_id           = "${anypoint_private_space.test.org_id}"
+			private_space_id = "${anypoint_private_space.test.id}"
+			cidr_block       = "10.0.0.0/22"
+			reserved_cidrs   = ["10.10.0.0/16"]
+		}
+
+		resource "anypoint_private_space_firewall_rules" "test" {
+			org_id           = "${anypoint_private_space_network.test.org_id}"
+			private_space_id = "${anypoint_private_space_network.test.private_space_id}"
+			%s
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), spaceName, rules)
+}
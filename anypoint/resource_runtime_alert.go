@@ -0,0 +1,226 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceRuntimeAlert() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceRuntimeAlertCreate,
+		Read:          resourceRuntimeAlertRead,
+		Update:        resourceRuntimeAlertUpdate,
+		Delete:        resourceRuntimeAlertDelete,
+		CustomizeDiff: resourceRuntimeAlertCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importEnvScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"severity": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sdk.AlertSeverityWarning,
+				ValidateFunc: validation.StringInSlice([]string{sdk.AlertSeverityCritical, sdk.AlertSeverityWarning, sdk.AlertSeverityInfo}, false),
+			},
+			"resource_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "cloudhub-application, or server, application and cluster for hybrid resources",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{sdk.AlertResourceCloudHubApplication, sdk.AlertResourceHybridServer,
+					sdk.AlertResourceHybridApplication, sdk.AlertResourceHybridCluster}, false),
+			},
+			"resources": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "The names of the CloudHub applications or the IDs of the hybrid resources watched",
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"condition": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{sdk.AlertConditionCPU, sdk.AlertConditionMemory, sdk.AlertConditionEventCount,
+					sdk.AlertConditionDeploymentFailed, sdk.AlertConditionCustomEvent}, false),
+			},
+			"operator": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "How the metric is compared to the threshold. Only for cpu, memory and event-count",
+				Optional:     true,
+				Default:      "ABOVE",
+				ValidateFunc: validation.StringInSlice([]string{"ABOVE", "BELOW"}, false),
+			},
+			"threshold": &schema.Schema{
+				Type:        schema.TypeFloat,
+				Description: "A percentage for cpu and memory, a number of events for event-count",
+				Optional:    true,
+			},
+			"period": &schema.Schema{
+				Type:         schema.TypeInt,
+				Description:  "How long the threshold must be crossed before alerting, in minutes",
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"custom_event_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The name of the custom event. Only for custom-event",
+				Optional:    true,
+			},
+			"emails": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceRuntimeAlertCreate(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+
+	alert, err := rm.CreateRuntimeAlert(d.Get("org_id").(string), d.Get("env_id").(string), getRuntimeAlertFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(alert.ID)
+
+	return resourceRuntimeAlertRead(d, conf)
+}
+
+func resourceRuntimeAlertRead(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+
+	alert, err := rm.GetRuntimeAlert(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Runtime alert %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading runtime alert %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", alert.Name)
+	d.Set("severity", alert.Severity)
+	d.Set("resource_type", alert.ResourceType)
+	d.Set("condition", alert.Condition.Type)
+	d.Set("threshold", alert.Condition.Threshold)
+	d.Set("custom_event_name", alert.Condition.CustomEventName)
+	d.Set("enabled", alert.Enabled)
+
+	if alert.Condition.Operator != "" {
+		d.Set("operator", alert.Condition.Operator)
+	}
+
+	if alert.Condition.Period != 0 {
+		d.Set("period", alert.Condition.Period)
+	}
+
+	if err := d.Set("resources", alert.Resources); err != nil {
+		return err
+	}
+
+	if err := d.Set("emails", alert.Recipients); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceRuntimeAlertUpdate(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+
+	if _, err := rm.UpdateRuntimeAlert(d.Get("org_id").(string), d.Get("env_id").(string), d.Id(), getRuntimeAlertFromData(d)); err != nil {
+		return err
+	}
+
+	return resourceRuntimeAlertRead(d, conf)
+}
+
+func resourceRuntimeAlertDelete(d *schema.ResourceData, conf interface{}) error {
+	rm := conf.(*Config).AnypointClient.RuntimeManager
+
+	return rm.DeleteRuntimeAlert(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+}
+
+//resourceRuntimeAlertCustomizeDiff checks that the attributes set match the condition
+func resourceRuntimeAlertCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	if !d.NewValueKnown("condition") {
+		return nil
+	}
+
+	condition := d.Get("condition").(string)
+	_, hasThreshold := d.GetOk("threshold")
+	_, hasCustomEvent := d.GetOk("custom_event_name")
+
+	switch condition {
+	case sdk.AlertConditionCPU, sdk.AlertConditionMemory, sdk.AlertConditionEventCount:
+		if !hasThreshold && d.NewValueKnown("threshold") {
+			return fmt.Errorf("threshold is required by the %s condition", condition)
+		}
+	case sdk.AlertConditionCustomEvent:
+		if !hasCustomEvent && d.NewValueKnown("custom_event_name") {
+			return fmt.Errorf("custom_event_name is required by the %s condition", condition)
+		}
+	}
+
+	if hasCustomEvent && condition != sdk.AlertConditionCustomEvent {
+		return fmt.Errorf("custom_event_name can only be set with the %s condition", sdk.AlertConditionCustomEvent)
+	}
+
+	return nil
+}
+
+func getRuntimeAlertFromData(d *schema.ResourceData) sdk.RuntimeAlert {
+	alert := sdk.RuntimeAlert{
+		Name:         d.Get("name").(string),
+		Severity:     d.Get("severity").(string),
+		ResourceType: d.Get("resource_type").(string),
+		Resources:    expandStringList(d.Get("resources").(*schema.Set).List()),
+		Recipients:   expandStringList(d.Get("emails").(*schema.Set).List()),
+		Enabled:      d.Get("enabled").(bool),
+		Condition: sdk.RuntimeAlertCondition{
+			Type:            d.Get("condition").(string),
+			CustomEventName: d.Get("custom_event_name").(string),
+		},
+	}
+
+	switch alert.Condition.Type {
+	case sdk.AlertConditionCPU, sdk.AlertConditionMemory, sdk.AlertConditionEventCount:
+		alert.Condition.Operator = d.Get("operator").(string)
+		alert.Condition.Threshold = d.Get("threshold").(float64)
+		alert.Condition.Period = d.Get("period").(int)
+	}
+
+	return alert
+}
@@ -0,0 +1,97 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccRuntimeAlert_cpu(t *testing.T) {
+	var providers []*schema.Provider
+
+	name := fmt.Sprintf("test-alert-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+			testAccPreCheckVars(t, "ANYPOINT_CH_APP_NAME")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckRuntimeAlertDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuntimeAlertConfig_cpu(name, "CRITICAL", ""),
+				ExpectError: regexp.MustCompile("threshold is required"),
+			},
+			{
+				Config: testAccRuntimeAlertConfig_cpu(name, "CRITICAL", "threshold = 80"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_runtime_alert.test", "threshold", "80"),
+					resource.TestCheckResourceAttr("anypoint_runtime_alert.test", "emails.#", "2"),
+				),
+			},
+			{
+				Config: testAccRuntimeAlertConfig_cpu(name, "WARNING", "threshold = 60"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_runtime_alert.test", "severity", "WARNING"),
+					resource.TestCheckResourceAttr("anypoint_runtime_alert.test", "threshold", "60"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_runtime_alert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_runtime_alert.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimeAlertDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	rm := provider.Meta().(*Config).AnypointClient.RuntimeManager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_runtime_alert" {
+			continue
+		}
+
+		_, err := rm.GetRuntimeAlert(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found runtime alert with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccRuntimeAlertConfig_cpu(name, severity, threshold string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_runtime_alert" "test" {
+			org_id        = "%s"
+			env_id        = "%s"
+			name          = "%s"
+			severity      = "%s"
+			resource_type = "cloudhub-application"
+			resources     = ["%s"]
+			condition     = "cpu"
+			period        = 10
+			emails        = ["ops@example.com", "oncall@example.com"]
+			%s
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), name, severity, os.Getenv("ANYPOINT_CH_APP_NAME"), threshold)
+}
@@ -0,0 +1,151 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceRuntimeFabric() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceRuntimeFabricCreate,
+		Read:   resourceRuntimeFabricRead,
+		Update: resourceRuntimeFabricUpdate,
+		Delete: resourceRuntimeFabricDelete,
+		Importer: &schema.ResourceImporter{
+			State: importOrgScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the fabric",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vendor": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The Kubernetes distribution of the cluster. Example: eks, aks, gke, openshift",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The control plane region the fabric connects to. Example: us-east-1",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"ingress_domains": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "The domains of the ingress template applied to the applications. Example: *.apps.example.com",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"activation_data": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Passed to the Runtime Fabric installer to register the cluster",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRuntimeFabricCreate(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+	orgID := d.Get("org_id").(string)
+
+	fabric, err := rtf.CreateFabric(orgID, sdk.Fabric{
+		Name:   d.Get("name").(string),
+		Vendor: d.Get("vendor").(string),
+		Region: d.Get("region").(string),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fabric.ID)
+
+	if domains := d.Get("ingress_domains").(*schema.Set); domains.Len() > 0 {
+		if _, err := rtf.SetFabricIngress(orgID, fabric.ID, sdk.FabricIngress{Domains: expandStringList(domains.List())}); err != nil {
+			return err
+		}
+	}
+
+	return resourceRuntimeFabricRead(d, conf)
+}
+
+func resourceRuntimeFabricRead(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+
+	fabric, err := rtf.GetFabric(d.Get("org_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Fabric %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading fabric %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", fabric.Name)
+	d.Set("vendor", fabric.Vendor)
+	d.Set("region", fabric.Region)
+	d.Set("activation_data", fabric.ActivationData)
+	d.Set("status", fabric.Status)
+	d.Set("version", fabric.Version)
+
+	domains := []string{}
+	if fabric.Ingress != nil {
+		domains = fabric.Ingress.Domains
+	}
+
+	if err := d.Set("ingress_domains", domains); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceRuntimeFabricUpdate(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+	orgID := d.Get("org_id").(string)
+
+	if d.HasChange("name") {
+		if _, err := rtf.RenameFabric(orgID, d.Id(), d.Get("name").(string)); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("ingress_domains") {
+		domains := expandStringList(d.Get("ingress_domains").(*schema.Set).List())
+		if _, err := rtf.SetFabricIngress(orgID, d.Id(), sdk.FabricIngress{Domains: domains}); err != nil {
+			return err
+		}
+	}
+
+	return resourceRuntimeFabricRead(d, conf)
+}
+
+func resourceRuntimeFabricDelete(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+
+	return rtf.DeleteFabric(d.Get("org_id").(string), d.Id())
+}
@@ -0,0 +1,117 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+//resourceRuntimeFabricAssociation manages every association of a fabric, so a fabric should only be
+//referenced by one of these resources
+func resourceRuntimeFabricAssociation() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceRuntimeFabricAssociationCreate,
+		Read:   resourceRuntimeFabricAssociationRead,
+		Update: resourceRuntimeFabricAssociationCreate,
+		Delete: resourceRuntimeFabricAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: importOrgScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the fabric",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"fabric_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"association": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"org_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The business group allowed to deploy to the fabric. Example: ${ap_bg.retail.id}",
+							Required:    true,
+						},
+						"env_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The environment allowed to deploy to the fabric, or \"all\" for every environment of the business group",
+							Optional:    true,
+							Default:     sdk.FabricAllEnvironments,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+//resourceRuntimeFabricAssociationCreate replaces the associations of the fabric. It is also used for updates
+func resourceRuntimeFabricAssociationCreate(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+	fabricID := d.Get("fabric_id").(string)
+
+	associations := []sdk.FabricAssociation{}
+	for _, a := range d.Get("association").(*schema.Set).List() {
+		association := a.(map[string]interface{})
+		associations = append(associations, sdk.FabricAssociation{
+			OrganizationID: association["org_id"].(string),
+			EnvironmentID:  association["env_id"].(string),
+		})
+	}
+
+	if err := rtf.SetFabricAssociations(d.Get("org_id").(string), fabricID, associations); err != nil {
+		return err
+	}
+
+	d.SetId(fabricID)
+
+	return resourceRuntimeFabricAssociationRead(d, conf)
+}
+
+func resourceRuntimeFabricAssociationRead(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+
+	associations, err := rtf.GetFabricAssociations(d.Get("org_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Fabric %s not found. Removing its associations from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading the associations of fabric %s : %s", d.Id(), err)
+	}
+
+	flattened := make([]interface{}, 0, len(associations))
+	for _, association := range associations {
+		flattened = append(flattened, map[string]interface{}{
+			"org_id": association.OrganizationID,
+			"env_id": association.EnvironmentID,
+		})
+	}
+
+	d.Set("fabric_id", d.Id())
+
+	if err := d.Set("association", flattened); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceRuntimeFabricAssociationDelete(d *schema.ResourceData, conf interface{}) error {
+	rtf := conf.(*Config).AnypointClient.RuntimeFabric
+
+	return rtf.SetFabricAssociations(d.Get("org_id").(string), d.Id(), nil)
+}
@@ -0,0 +1,107 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"testing"
+)
+
+func TestAccRuntimeFabric_association(t *testing.T) {
+	var providers []*schema.Provider
+
+	suffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckRuntimeFabricDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeFabricConfig_association(suffix, `"*.apps.example.com"`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("anypoint_runtime_fabric.test", "activation_data"),
+					resource.TestCheckResourceAttr("anypoint_runtime_fabric.test", "ingress_domains.#", "1"),
+					resource.TestCheckResourceAttr("anypoint_runtime_fabric_association.test", "association.#", "1"),
+				),
+			},
+			{
+				Config: testAccRuntimeFabricConfig_association(suffix, `"*.apps.example.com", "*.internal.example.com"`, `
+					association {
+						org_id = "${ap_bg.test.id}"
+					}
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_runtime_fabric.test", "ingress_domains.#", "2"),
+					resource.TestCheckResourceAttr("anypoint_runtime_fabric_association.test", "association.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_runtime_fabric_association.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_runtime_fabric_association.test"]
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["org_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimeFabricDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	rtf := provider.Meta().(*Config).AnypointClient.RuntimeFabric
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_runtime_fabric" {
+			continue
+		}
+
+		_, err := rtf.GetFabric(rs.Primary.Attributes["org_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found fabric with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccRuntimeFabricConfig_association(suffix, domains, associations string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_runtime_fabric" "test" {
+			org_id          = "%s"
+			name            = "test-rtf-%s"
+			vendor          = "eks"
+			region          = "us-east-1"
+			ingress_domains = [%s]
+		}
+
+		resource "ap_bg" "test" {
+			name          = "test-rtf-bg-%s"
+			parent_org_id = "${anypoint_runtime_fabric.test.org_id}"
+		}
+
+		resource "anypoint_runtime_fabric_association" "test" {
+			org_id    = "${anypoint_runtime_fabric.test.org_id}"
+			fabric_id = "${anypoint_runtime_fabric.test.id}"
+
+			association {
+				org_id = "${anypoint_runtime_fabric.test.org_id}"
+				env_id = "%s"
+			}
+			%s
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), suffix, domains, suffix, os.Getenv("ANYPOINT_ENV_ID"), associations)
+}
@@ -0,0 +1,102 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceSecretGroup() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceSecretGroupCreate,
+		Read:   resourceSecretGroupRead,
+		Update: resourceSecretGroupUpdate,
+		Delete: resourceSecretGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: importEnvScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"downloadable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the secrets of the group can be downloaded once uploaded",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func resourceSecretGroupCreate(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	id, err := sm.CreateSecretGroup(d.Get("org_id").(string), d.Get("env_id").(string), getSecretGroupFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceSecretGroupRead(d, conf)
+}
+
+func resourceSecretGroupRead(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	group, err := sm.GetSecretGroup(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Secret group %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading secret group %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", group.Name)
+	d.Set("downloadable", group.Downloadable)
+
+	return nil
+}
+
+func resourceSecretGroupUpdate(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	if err := sm.UpdateSecretGroup(d.Get("org_id").(string), d.Get("env_id").(string), d.Id(), getSecretGroupFromData(d)); err != nil {
+		return err
+	}
+
+	return resourceSecretGroupRead(d, conf)
+}
+
+func resourceSecretGroupDelete(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	return sm.DeleteSecretGroup(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+}
+
+func getSecretGroupFromData(d *schema.ResourceData) sdk.SecretGroup {
+	return sdk.SecretGroup{
+		Name:         d.Get("name").(string),
+		Downloadable: d.Get("downloadable").(bool),
+	}
+}
@@ -0,0 +1,177 @@
+package anypoint
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAccSecretGroup_tlsContext(t *testing.T) {
+	var providers []*schema.Provider
+
+	suffix := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	dir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	certPath, keyPath := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if err := testAccWriteSelfSignedCertificate(certPath, keyPath); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckEnvironment(t)
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckSecretGroupDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretGroupConfig_tlsContext(suffix, certPath, keyPath, "TLSv1.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("anypoint_keystore.test", "content_hash"),
+					resource.TestCheckResourceAttrSet("anypoint_keystore.test", "expiration_date"),
+					resource.TestCheckResourceAttrSet("anypoint_certificate.test", "path"),
+					resource.TestCheckResourceAttrPair("anypoint_tls_context.test", "keystore_id", "anypoint_keystore.test", "id"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := testAccWriteSelfSignedCertificate(certPath, keyPath); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccSecretGroupConfig_tlsContext(suffix, certPath, keyPath, "TLSv1.3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_tls_context.test", "min_tls_version", "TLSv1.3"),
+				),
+			},
+			{
+				ResourceName:      "anypoint_tls_context.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["anypoint_tls_context.test"]
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["env_id"], rs.Primary.Attributes["secret_group_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckSecretGroupDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	sm := provider.Meta().(*Config).AnypointClient.SecretsManager
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_secret_group" {
+			continue
+		}
+
+		_, err := sm.GetSecretGroup(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found secret group with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//testAccWriteSelfSignedCertificate writes a new self signed certificate and its key in PEM format
+func testAccWriteSelfSignedCertificate(certPath, keyPath string) error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "terraform-provider-anypoint.test"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})
+	if err := ioutil.WriteFile(certPath, certPEM, 0600); err != nil {
+		return err
+	}
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return ioutil.WriteFile(keyPath, keyPEM, 0600)
+}
+
+func testAccSecretGroupConfig_tlsContext(suffix, certPath, keyPath, minTLSVersion string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_secret_group" "test" {
+			org_id = "%s"
+			env_id = "%s"
+			name   = "test-secrets-%s"
+		}
+
+		resource "anypoint_keystore" "test" {
+			org_id           = "${anypoint_secret_group.test.org_id}"
+			env_id           = "${anypoint_secret_group.test.env_id}"
+			secret_group_id  = "${anypoint_secret_group.test.id}"
+			name             = "server"
+			type             = "PEM"
+			certificate_path = "%s"
+			key_path         = "%s"
+		}
+
+		resource "anypoint_truststore" "test" {
+			org_id          = "${anypoint_secret_group.test.org_id}"
+			env_id          = "${anypoint_secret_group.test.env_id}"
+			secret_group_id = "${anypoint_secret_group.test.id}"
+			name            = "clients"
+			type            = "PEM"
+			file_path       = "%s"
+		}
+
+		resource "anypoint_certificate" "test" {
+			org_id          = "${anypoint_secret_group.test.org_id}"
+			env_id          = "${anypoint_secret_group.test.env_id}"
+			secret_group_id = "${anypoint_secret_group.test.id}"
+			name            = "server-cert"
+			file_path       = "%s"
+		}
+
+		resource "anypoint_tls_context" "test" {
+			org_id                        = "${anypoint_secret_group.test.org_id}"
+			env_id                        = "${anypoint_secret_group.test.env_id}"
+			secret_group_id               = "${anypoint_secret_group.test.id}"
+			name                          = "inbound"
+			keystore_id                   = "${anypoint_keystore.test.id}"
+			truststore_id                 = "${anypoint_truststore.test.id}"
+			min_tls_version               = "%s"
+			alpn_protocols                = ["h2", "http/1.1"]
+			enable_client_cert_validation = true
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"), suffix, certPath, keyPath, certPath, certPath, minTLSVersion)
+}
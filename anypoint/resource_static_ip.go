@@ -0,0 +1,125 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceStaticIP() *schema.Resource {
+
+	return &schema.Resource{
+		Create: resourceStaticIPCreate,
+		Read:   resourceStaticIPRead,
+		Update: resourceStaticIPUpdate,
+		Delete: resourceStaticIPDelete,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group. Its static_ips entitlement is consumed",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The CloudHub region of the IP. Example: us-east-1",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"application_domain": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The domain of the CloudHub application the IP is bound to",
+				Optional:    true,
+			},
+			"allow_release": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Guards the address against accidental release: destroying the resource fails while false. A released address goes back to the CloudHub pool and cannot be claimed again",
+				Optional:    true,
+				Default:     false,
+			},
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStaticIPCreate(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+	orgID := d.Get("org_id").(string)
+	envID := d.Get("env_id").(string)
+
+	ip, err := ch.AllocateStaticIP(orgID, envID, d.Get("region").(string))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(ip.Address)
+
+	if domain := d.Get("application_domain").(string); domain != "" {
+		if _, err := ch.AssignStaticIP(orgID, envID, ip.Address, domain); err != nil {
+			return err
+		}
+	}
+
+	return resourceStaticIPRead(d, conf)
+}
+
+func resourceStaticIPRead(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	ip, err := ch.GetStaticIP(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Static IP %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading static IP %s : %s", d.Id(), err)
+	}
+
+	d.Set("address", ip.Address)
+	d.Set("region", ip.Region)
+	d.Set("application_domain", ip.ApplicationDomain)
+	d.Set("status", ip.Status)
+
+	return nil
+}
+
+func resourceStaticIPUpdate(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	if d.HasChange("application_domain") {
+		_, err := ch.AssignStaticIP(d.Get("org_id").(string), d.Get("env_id").(string), d.Id(), d.Get("application_domain").(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceStaticIPRead(d, conf)
+}
+
+func resourceStaticIPDelete(d *schema.ResourceData, conf interface{}) error {
+	if !d.Get("allow_release").(bool) {
+		return fmt.Errorf("static IP %s is protected. Set allow_release = true and apply before destroying it", d.Id())
+	}
+
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	return ch.ReleaseStaticIP(d.Get("org_id").(string), d.Get("env_id").(string), d.Id())
+}
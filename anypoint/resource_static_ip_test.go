@@ -0,0 +1,64 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestAccStaticIP_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckEnvironment(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckStaticIPDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStaticIPConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("anypoint_static_ip.test", "address", regexp.MustCompile(`^\d+\.\d+\.\d+\.\d+$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStaticIPDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	ch := provider.Meta().(*Config).AnypointClient.CloudHub
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_static_ip" {
+			continue
+		}
+
+		_, err := ch.GetStaticIP(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["env_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found static IP %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccStaticIPConfig_basic() string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_static_ip" "test" {
+			org_id        = "%s"
+			env_id        = "%s"
+			region        = "us-east-1"
+			allow_release = true
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_ENV_ID"))
+}
@@ -0,0 +1,198 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+	"strings"
+)
+
+func resourceTLSContext() *schema.Resource {
+	tlsVersions := []string{"TLSv1.1", "TLSv1.2", "TLSv1.3"}
+
+	return &schema.Resource{
+		Create: resourceTLSContextCreate,
+		Read:   resourceTLSContextRead,
+		Update: resourceTLSContextUpdate,
+		Delete: resourceTLSContextDelete,
+		Importer: &schema.ResourceImporter{
+			State: importSecretGroupScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"target": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The runtime using the context",
+				Optional:     true,
+				Default:      sdk.TLSContextTargetFlexGateway,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{sdk.TLSContextTargetFlexGateway, sdk.TLSContextTargetMule}, false),
+			},
+			"keystore_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "A keystore of the same secret group",
+				Optional:    true,
+			},
+			"truststore_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "A truststore of the same secret group",
+				Optional:    true,
+			},
+			"min_tls_version": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "TLSv1.2",
+				ValidateFunc: validation.StringInSlice(tlsVersions, false),
+			},
+			"max_tls_version": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "TLSv1.3",
+				ValidateFunc: validation.StringInSlice(tlsVersions, false),
+			},
+			"cipher_suites": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The cipher suites enabled, in order of preference. Defaults to the ones of the runtime",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"alpn_protocols": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Example: [\"h2\", \"http/1.1\"]",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"enable_client_cert_validation": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether clients must present a certificate trusted by the truststore",
+				Optional:    true,
+				Default:     false,
+			},
+			"skip_server_cert_validation": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceTLSContextCreate(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	id, err := sm.CreateTLSContext(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), getTLSContextFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceTLSContextRead(d, conf)
+}
+
+func resourceTLSContextRead(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	context, err := sm.GetTLSContext(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("TLS context %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading TLS context %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", context.Name)
+	d.Set("target", context.Target)
+	d.Set("keystore_id", secretRefID(context.Keystore, sdk.SecretTypeKeystore))
+	d.Set("truststore_id", secretRefID(context.Truststore, sdk.SecretTypeTruststore))
+	d.Set("min_tls_version", context.MinTLSVersion)
+	d.Set("max_tls_version", context.MaxTLSVersion)
+	d.Set("enable_client_cert_validation", context.EnableClientCertValidation)
+	d.Set("skip_server_cert_validation", context.SkipServerCertValidation)
+
+	if err := d.Set("cipher_suites", context.CipherSuites); err != nil {
+		return err
+	}
+
+	if err := d.Set("alpn_protocols", context.ALPNProtocols); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceTLSContextUpdate(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	err := sm.UpdateTLSContext(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), d.Id(), getTLSContextFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceTLSContextRead(d, conf)
+}
+
+func resourceTLSContextDelete(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	return sm.DeleteSecret(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), sdk.SecretTypeTLSContext, d.Id())
+}
+
+func getTLSContextFromData(d *schema.ResourceData) sdk.TLSContext {
+	context := sdk.TLSContext{
+		Name:                       d.Get("name").(string),
+		Target:                     d.Get("target").(string),
+		MinTLSVersion:              d.Get("min_tls_version").(string),
+		MaxTLSVersion:              d.Get("max_tls_version").(string),
+		CipherSuites:               expandStringList(d.Get("cipher_suites").([]interface{})),
+		ALPNProtocols:              expandStringList(d.Get("alpn_protocols").([]interface{})),
+		EnableClientCertValidation: d.Get("enable_client_cert_validation").(bool),
+		SkipServerCertValidation:   d.Get("skip_server_cert_validation").(bool),
+	}
+
+	if id := d.Get("keystore_id").(string); id != "" {
+		context.Keystore = &sdk.SecretRef{Path: sdk.SecretTypeKeystore + "/" + id}
+	}
+
+	if id := d.Get("truststore_id").(string); id != "" {
+		context.Truststore = &sdk.SecretRef{Path: sdk.SecretTypeTruststore + "/" + id}
+	}
+
+	return context
+}
+
+//secretRefID returns the ID of the secret referenced by path, such as <id> for keystores/<id>
+func secretRefID(ref *sdk.SecretRef, secretType string) string {
+	if ref == nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(ref.Path, secretType+"/")
+}
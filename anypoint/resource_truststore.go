@@ -0,0 +1,143 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceTruststore() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceTruststoreCreate,
+		Read:          resourceTruststoreRead,
+		Update:        resourceTruststoreUpdate,
+		Delete:        resourceTruststoreDelete,
+		CustomizeDiff: resourceTruststoreCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importSecretGroupScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"env_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{sdk.StoreTypeJKS, sdk.StoreTypePKCS12, sdk.StoreTypeJCEKS, sdk.StoreTypePEM}, false),
+			},
+			"file_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Path to the truststore file, or to the PEM bundle of trusted certificates",
+				Required:    true,
+			},
+			"passphrase": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"content_hash": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "SHA-256 of the uploaded file. A change uploads it again",
+				Computed:    true,
+			},
+			"path": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The reference used by TLS contexts. Example: truststores/<id>",
+				Computed:    true,
+			},
+			"expiration_date": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTruststoreCreate(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	id, err := sm.CreateTruststore(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), getTruststoreFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceTruststoreRead(d, conf)
+}
+
+func resourceTruststoreRead(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	truststore, err := sm.GetTruststore(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("Truststore %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading truststore %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", truststore.Name)
+	d.Set("type", truststore.Type)
+	d.Set("path", truststore.Meta.Path)
+	d.Set("expiration_date", truststore.ExpirationDate)
+
+	return nil
+}
+
+func resourceTruststoreUpdate(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	err := sm.UpdateTruststore(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), d.Id(), getTruststoreFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	return resourceTruststoreRead(d, conf)
+}
+
+func resourceTruststoreDelete(d *schema.ResourceData, conf interface{}) error {
+	sm := conf.(*Config).AnypointClient.SecretsManager
+
+	return sm.DeleteSecret(d.Get("org_id").(string), d.Get("env_id").(string), d.Get("secret_group_id").(string), sdk.SecretTypeTruststore, d.Id())
+}
+
+func resourceTruststoreCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	return setSecretContentHash(d, "file_path")
+}
+
+func getTruststoreFromData(d *schema.ResourceData) sdk.Truststore {
+	return sdk.Truststore{
+		Name:       d.Get("name").(string),
+		Type:       d.Get("type").(string),
+		Passphrase: d.Get("passphrase").(string),
+		FilePath:   d.Get("file_path").(string),
+	}
+}
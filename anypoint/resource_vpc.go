@@ -0,0 +1,245 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+func resourceVPC() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceVPCCreate,
+		Read:          resourceVPCRead,
+		Update:        resourceVPCUpdate,
+		Delete:        resourceVPCDelete,
+		CustomizeDiff: resourceVPCCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: importOrgScopedResource,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the VPC. Its vpcs entitlement is consumed",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The CloudHub region of the VPC. Example: us-east-1",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"cidr_block": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.CIDRNetwork(16, 24),
+			},
+			"is_default": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether applications of the associated environments are deployed to this VPC by default",
+				Optional:    true,
+			},
+			"internal_dns_servers": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.SingleIP(),
+				},
+			},
+			"internal_dns_special_domains": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "Domains resolved through the internal DNS servers",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"associated_environments": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "IDs of the environments whose applications run in the VPC",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"shared_with": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "IDs of the business groups the VPC is shared with",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"firewall_rule": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr_block": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.CIDRNetwork(0, 32),
+						},
+						"protocol": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+						},
+						"from_port": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+						"to_port": &schema.Schema{
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 65535),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVPCCreate(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	vpc, err := ch.CreateVPC(d.Get("org_id").(string), getVPCFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(vpc.ID)
+
+	return resourceVPCRead(d, conf)
+}
+
+func resourceVPCRead(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	vpc, err := ch.GetVPC(d.Get("org_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("VPC %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading VPC %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", vpc.Name)
+	d.Set("region", vpc.Region)
+	d.Set("cidr_block", vpc.CidrBlock)
+	d.Set("is_default", vpc.IsDefault)
+	d.Set("internal_dns_servers", vpc.InternalDNS.DNSServers)
+	d.Set("internal_dns_special_domains", vpc.InternalDNS.SpecialDomains)
+	d.Set("associated_environments", vpc.AssociatedEnvironments)
+	d.Set("shared_with", vpc.SharedWith)
+
+	if err := d.Set("firewall_rule", flattenVPCFirewallRules(vpc.FirewallRules)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceVPCUpdate(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	vpc := getVPCFromData(d)
+	vpc.ID = d.Id()
+
+	if _, err := ch.UpdateVPC(d.Get("org_id").(string), vpc); err != nil {
+		return err
+	}
+
+	return resourceVPCRead(d, conf)
+}
+
+func resourceVPCDelete(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	return ch.DeleteVPC(d.Get("org_id").(string), d.Id())
+}
+
+//resourceVPCCustomizeDiff checks at plan time that the owning business group can afford one more VPC
+func resourceVPCCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("org_id") {
+		return nil
+	}
+
+	client := conf.(*Config).AnypointClient
+	orgID := d.Get("org_id").(string)
+
+	bg, err := client.AccessManagement.GetBusinessGroupByID(orgID)
+	if err != nil {
+		return err
+	}
+
+	vpcs, err := client.CloudHub.ListVPCs(orgID)
+	if err != nil {
+		return err
+	}
+
+	owned := 0
+	for _, vpc := range vpcs {
+		if vpc.OwnerID == "" || vpc.OwnerID == orgID {
+			owned++
+		}
+	}
+
+	return checkEntitlementAvailable(bg, "vpcs", bg.Entitlements.VPCs, float64(owned), 1)
+}
+
+func getVPCFromData(d *schema.ResourceData) sdk.VPC {
+	vpc := sdk.VPC{
+		Name:      d.Get("name").(string),
+		Region:    d.Get("region").(string),
+		CidrBlock: d.Get("cidr_block").(string),
+		IsDefault: d.Get("is_default").(bool),
+		OwnerID:   d.Get("org_id").(string),
+		InternalDNS: sdk.VPCInternalDNS{
+			DNSServers:     expandStringList(d.Get("internal_dns_servers").([]interface{})),
+			SpecialDomains: expandStringList(d.Get("internal_dns_special_domains").([]interface{})),
+		},
+		AssociatedEnvironments: expandStringList(d.Get("associated_environments").(*schema.Set).List()),
+		SharedWith:             expandStringList(d.Get("shared_with").(*schema.Set).List()),
+	}
+
+	rules := d.Get("firewall_rule").(*schema.Set).List()
+	vpc.FirewallRules = make([]sdk.VPCFirewallRule, 0, len(rules))
+	for _, r := range rules {
+		rule := r.(map[string]interface{})
+		vpc.FirewallRules = append(vpc.FirewallRules, sdk.VPCFirewallRule{
+			CidrBlock: rule["cidr_block"].(string),
+			Protocol:  rule["protocol"].(string),
+			FromPort:  rule["from_port"].(int),
+			ToPort:    rule["to_port"].(int),
+		})
+	}
+
+	return vpc
+}
+
+func flattenVPCFirewallRules(rules []sdk.VPCFirewallRule) []interface{} {
+	result := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		result = append(result, map[string]interface{}{
+			"cidr_block": rule.CidrBlock,
+			"protocol":   rule.Protocol,
+			"from_port":  rule.FromPort,
+			"to_port":    rule.ToPort,
+		})
+	}
+
+	return result
+}
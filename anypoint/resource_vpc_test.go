@@ -0,0 +1,83 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"testing"
+)
+
+func TestAccVPC_basic(t *testing.T) {
+	var providers []*schema.Provider
+
+	vpcName := fmt.Sprintf("test-vpc-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckEnvironment(t) },
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckVPCDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConfig_basic(vpcName, "10.111.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_vpc.test", "name", vpcName),
+					resource.TestCheckResourceAttr("anypoint_vpc.test", "firewall_rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccVPCConfig_basic(vpcName, "10.112.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_vpc.test", "firewall_rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	ch := provider.Meta().(*Config).AnypointClient.CloudHub
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_vpc" {
+			continue
+		}
+
+		_, err := ch.GetVPC(rs.Primary.Attributes["org_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found VPC with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccVPCConfig_basic(vpcName, allowedCidr string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_vpc" "test" {
+			org_id                       = "%s"
+			name                         = "%s"
+			region                       = "us-east-1"
+			cidr_block                   = "10.0.0.0/24"
+			internal_dns_servers         = ["10.1.1.1"]
+			internal_dns_special_domains = ["corp.example.com"]
+			associated_environments      = ["%s"]
+
+			firewall_rule {
+				cidr_block = "%s"
+				protocol   = "tcp"
+				from_port  = 8081
+				to_port    = 8082
+			}
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), vpcName, os.Getenv("ANYPOINT_ENV_ID"), allowedCidr)
+}
@@ -0,0 +1,307 @@
+package anypoint
+
+import (
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"log"
+)
+
+const (
+	vpnRoutingStatic = "static"
+	vpnRoutingBGP    = "bgp"
+)
+
+func resourceVPN() *schema.Resource {
+
+	return &schema.Resource{
+		Create:        resourceVPNCreate,
+		Read:          resourceVPNRead,
+		Update:        resourceVPNUpdate,
+		Delete:        resourceVPNDelete,
+		CustomizeDiff: resourceVPNCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the business group owning the VPC. Its vpns entitlement is consumed",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"vpc_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remote_ip_address": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The public IP of the remote VPN gateway",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.SingleIP(),
+			},
+			"routing": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "static routes the remote_networks, bgp learns them from remote_asn",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{vpnRoutingStatic, vpnRoutingBGP}, false),
+			},
+			"remote_networks": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.CIDRNetwork(0, 32),
+				},
+			},
+			"remote_asn": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"local_asn": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"ike_version": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ikev2",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ikev1", "ikev2"}, false),
+			},
+			"tunnel": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"psk": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The pre-shared key of the tunnel",
+							Required:    true,
+							ForceNew:    true,
+							Sensitive:   true,
+						},
+						"ptp_cidr": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The /30 inside tunnel CIDR, within 169.254.0.0/16",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"startup_action": &schema.Schema{
+							Type:         schema.TypeString,
+							Description:  "start initiates the tunnel, add waits for the remote gateway",
+							Optional:     true,
+							Default:      "start",
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"start", "add"}, false),
+						},
+					},
+				},
+			},
+			"allow_destroy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Must be set to true, and applied, before the VPN can be destroyed",
+				Optional:    true,
+				Default:     false,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tunnel_status": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status_message": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"accepted_route_count": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVPNCreate(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	vpn, err := ch.CreateVPN(d.Get("org_id").(string), d.Get("vpc_id").(string), getVPNFromData(d))
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(vpn.ID)
+
+	return resourceVPNRead(d, conf)
+}
+
+func resourceVPNRead(d *schema.ResourceData, conf interface{}) error {
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	vpn, err := ch.GetVPN(d.Get("org_id").(string), d.Get("vpc_id").(string), d.Id())
+
+	if sdk.IsNotFound(err) {
+		log.Printf("VPN %s not found. Removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while reading VPN %s : %s", d.Id(), err)
+	}
+
+	d.Set("name", vpn.Name)
+	d.Set("remote_ip_address", vpn.Spec.RemoteIPAddress)
+	d.Set("local_asn", vpn.Spec.LocalAsn)
+	d.Set("remote_asn", vpn.Spec.RemoteAsn)
+	d.Set("state", vpn.State)
+
+	if vpn.Spec.IkeVersion != "" {
+		d.Set("ike_version", vpn.Spec.IkeVersion)
+	}
+
+	if vpn.Spec.RemoteAsn != 0 {
+		d.Set("routing", vpnRoutingBGP)
+	} else {
+		d.Set("routing", vpnRoutingStatic)
+	}
+
+	if err := d.Set("remote_networks", vpn.Spec.RemoteNetworks); err != nil {
+		return err
+	}
+
+	tunnels := make([]interface{}, 0, len(vpn.VPNTunnels))
+	for _, tunnel := range vpn.VPNTunnels {
+		tunnels = append(tunnels, map[string]interface{}{
+			"status":               tunnel.Status,
+			"status_message":       tunnel.StatusMessage,
+			"accepted_route_count": tunnel.AcceptedRouteCount,
+		})
+	}
+
+	if err := d.Set("tunnel_status", tunnels); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+//resourceVPNUpdate only records allow_destroy: every other attribute replaces the VPN
+func resourceVPNUpdate(d *schema.ResourceData, conf interface{}) error {
+	return resourceVPNRead(d, conf)
+}
+
+func resourceVPNDelete(d *schema.ResourceData, conf interface{}) error {
+	if !d.Get("allow_destroy").(bool) {
+		return fmt.Errorf("VPN %s (%s) is protected. Set allow_destroy = true and apply before destroying it", d.Get("name"), d.Id())
+	}
+
+	ch := conf.(*Config).AnypointClient.CloudHub
+
+	return ch.DeleteVPN(d.Get("org_id").(string), d.Get("vpc_id").(string), d.Id())
+}
+
+//resourceVPNCustomizeDiff checks the routing options and, for new VPNs, that the business group can afford
+//one more VPN
+func resourceVPNCustomizeDiff(d *schema.ResourceDiff, conf interface{}) error {
+	_, hasNetworks := d.GetOk("remote_networks")
+	_, hasAsn := d.GetOk("remote_asn")
+
+	switch d.Get("routing").(string) {
+	case vpnRoutingStatic:
+		if !hasNetworks || hasAsn {
+			return errors.New("static routing requires remote_networks and no remote_asn")
+		}
+	case vpnRoutingBGP:
+		if !hasAsn {
+			return errors.New("bgp routing requires remote_asn")
+		}
+	}
+
+	if d.Id() != "" || !d.NewValueKnown("org_id") {
+		return nil
+	}
+
+	client := conf.(*Config).AnypointClient
+	orgID := d.Get("org_id").(string)
+
+	bg, err := client.AccessManagement.GetBusinessGroupByID(orgID)
+	if err != nil {
+		return err
+	}
+
+	vpcs, err := client.CloudHub.ListVPCs(orgID)
+	if err != nil {
+		return err
+	}
+
+	//VPNs of VPCs shared with the business group are paid for by the owner of the VPC
+	inUse := 0
+	for _, vpc := range vpcs {
+		if vpc.OwnerID != "" && vpc.OwnerID != orgID {
+			continue
+		}
+
+		vpns, err := client.CloudHub.ListVPNs(orgID, vpc.ID)
+		if err != nil {
+			return err
+		}
+		inUse += len(vpns)
+	}
+
+	return checkEntitlementAvailable(bg, "vpns", bg.Entitlements.VPNs, float64(inUse), 1)
+}
+
+func getVPNFromData(d *schema.ResourceData) sdk.VPNConnection {
+	spec := sdk.VPNSpec{
+		RemoteIPAddress: d.Get("remote_ip_address").(string),
+		LocalAsn:        d.Get("local_asn").(int),
+		IkeVersion:      d.Get("ike_version").(string),
+	}
+
+	if d.Get("routing").(string) == vpnRoutingStatic {
+		spec.RemoteNetworks = expandStringList(d.Get("remote_networks").([]interface{}))
+	} else {
+		spec.RemoteAsn = d.Get("remote_asn").(int)
+	}
+
+	for _, t := range d.Get("tunnel").([]interface{}) {
+		tunnel := t.(map[string]interface{})
+		spec.TunnelConfigs = append(spec.TunnelConfigs, sdk.VPNTunnelConfig{
+			Psk:           tunnel["psk"].(string),
+			PtpCidr:       tunnel["ptp_cidr"].(string),
+			StartupAction: tunnel["startup_action"].(string),
+		})
+	}
+
+	return sdk.VPNConnection{
+		Name: d.Get("name").(string),
+		Spec: spec,
+	}
+}
@@ -0,0 +1,78 @@
+package anypoint
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/tech-nico/terraform-provider-anypoint/anypoint/sdk"
+	"os"
+	"testing"
+)
+
+func TestAccVPN_static(t *testing.T) {
+	var providers []*schema.Provider
+
+	vpnName := fmt.Sprintf("test-vpn-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckVars(t, "ANYPOINT_ORG_ID", "ANYPOINT_VPC_ID", "ANYPOINT_VPN_REMOTE_IP")
+		},
+		ProviderFactories: testAccProviderFactories(&providers),
+		CheckDestroy:      testAccCheckWithProviders(testAccCheckVPNDestroyWithProvider, &providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPNConfig_static(vpnName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("anypoint_vpn.test", "name", vpnName),
+					resource.TestCheckResourceAttrSet("anypoint_vpn.test", "state"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPNDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
+	ch := provider.Meta().(*Config).AnypointClient.CloudHub
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "anypoint_vpn" {
+			continue
+		}
+
+		_, err := ch.GetVPN(rs.Primary.Attributes["org_id"], rs.Primary.Attributes["vpc_id"], rs.Primary.ID)
+
+		if err == nil {
+			return fmt.Errorf("Found VPN with ID %s", rs.Primary.ID)
+		}
+
+		if !sdk.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccVPNConfig_static(vpnName string) string {
+
+	return fmt.Sprintf(`
+		resource "anypoint_vpn" "test" {
+			org_id            = "%s"
+			vpc_id            = "%s"
+			name              = "%s"
+			remote_ip_address = "%s"
+			routing           = "static"
+			remote_networks   = ["192.168.100.0/24"]
+			allow_destroy     = true
+
+			tunnel {
+				psk      = "%s"
+				ptp_cidr = "169.254.10.0/30"
+			}
+		}
+	`, os.Getenv("ANYPOINT_ORG_ID"), os.Getenv("ANYPOINT_VPC_ID"), vpnName, os.Getenv("ANYPOINT_VPN_REMOTE_IP"), acctest.RandString(20))
+}
@@ -150,7 +150,7 @@ func (auth *AccessManagement) GetBusinessGroup(parentID, bgName string) (Busines
 }
 
 // FindBusinessGroup search for the given business group (specified in the format "Parent\Child\Grand-Nephew") and
-//return its ID
+//return its ID. It fails when any group of the path cannot be found, rather than returning its closest ancestor
 
 func (auth *AccessManagement) FindBusinessGroup(path string) (string, error) {
 	currentOrgId := ""
@@ -161,11 +161,18 @@ func (auth *AccessManagement) FindBusinessGroup(path string) (string, error) {
 
 	subOrganizations := hierarchy.SubOrganizations
 
+	if len(groups) == 0 {
+		return "", fmt.Errorf("cannot find business group %s", path)
+	}
+
 	if len(groups) == 1 {
 		return hierarchy.ID, nil
 	}
 
-	for _, currGroup := range groups {
+	//The first group is the root organization itself
+	for _, currGroup := range groups[1:] {
+		found := false
+
 		for organization := 0; organization < len(subOrganizations); organization++ {
 			currOrg := subOrganizations[organization]
 
@@ -173,8 +180,14 @@ func (auth *AccessManagement) FindBusinessGroup(path string) (string, error) {
 				currentOrgId = currOrg.ID
 				log.Printf("The matched org name is: %s", currOrg.Name)
 				subOrganizations = currOrg.SubOrganizations
+				found = true
+				break
 			}
 		}
+
+		if !found {
+			return "", fmt.Errorf("cannot find business group %s : %s does not exist", path, currGroup)
+		}
 	}
 
 	if currentOrgId == "" {
@@ -238,8 +251,49 @@ func (auth *AccessManagement) FindUserByUsername(orgId, username string) (*User,
 	return &response.Data[0], nil
 }
 
-func (auth *AccessManagement) UpdateBusinessGroup() {
+//UpdateBusinessGroup replaces the name, parent, entitlements and settings (session timeout, MFA, domain) of an existing business group
+func (auth *AccessManagement) UpdateBusinessGroup(bg BusinessGroup) (BusinessGroup, error) {
+	if bg.ID == "" {
+		return BusinessGroup{}, errors.New("error when updating business group. No business group ID has been specified")
+	}
+
+	var response BusinessGroup
+
+	log.Printf("Updating BG %s [%s]", bg.Name, bg.ID)
+	err := auth.client.PUT(bg, organizationPath(bg.ID), &response)
+
+	if err != nil {
+		return BusinessGroup{}, fmt.Errorf("error while updating business group %s : %s", bg.ID, err)
+	}
+
+	return response, nil
+}
+
+//ListEnvironments returns the environments of a business group, not those of its sub organizations
+func (auth *AccessManagement) ListEnvironments(orgID string) ([]Environment, error) {
+	var response environments
+
+	err := auth.client.GET(environmentsPath(orgID), &response)
+
+	if err != nil {
+		return nil, fmt.Errorf("error while listing environments of business group %s : %s", orgID, err)
+	}
+
+	return response.Data, nil
+}
 
+//DeleteEnvironment deletes an environment. The API refuses to delete environments that still hold applications
+func (auth *AccessManagement) DeleteEnvironment(orgID, envID string) error {
+	resp := new(interface{})
+
+	log.Printf("Deleting environment %s of business group %s", envID, orgID)
+	err := auth.client.DELETE(nil, envPath(ENVIRONMENT, orgID, envID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting environment %s of business group %s : %s", envID, orgID, err)
+	}
+
+	return nil
 }
 
 //Create a new business group under the given business group ID. Returns the newly created business group ID
@@ -17,21 +17,54 @@ type AuthToken struct {
 }
 
 type Entitlements struct {
-	CreateSubOrgs      bool              `json:"createSubOrgs,omitempty"`
-	GlobalDeployment   bool              `json:"globalDeployment,omitempty"`
-	CreateEnvironments bool              `json:"createEnvironments,omitempty"`
-	ProductionVCores   EntitlementStatus `json:"vCoresProduction,omitempty"`
-	SandboxVCores      EntitlementStatus `json:"vCoresSandbox,omitempty"`
-	DesignVCores       EntitlementStatus `json:"vCoresDesign,omitempty"`
-	StaticIPs          EntitlementStatus `json:"staticIps,omitempty"`
-	VPCs               EntitlementStatus `json:"vpcs,omitempty"`
-	LoadBalancer       EntitlementStatus `json:"loadBalancer,omitempty"`
-	VPNs               EntitlementStatus `json:"vpns,omitempty"`
+	CreateSubOrgs           bool                `json:"createSubOrgs,omitempty"`
+	GlobalDeployment        bool                `json:"globalDeployment,omitempty"`
+	CreateEnvironments      bool                `json:"createEnvironments,omitempty"`
+	ProductionVCores        EntitlementStatus   `json:"vCoresProduction,omitempty"`
+	SandboxVCores           EntitlementStatus   `json:"vCoresSandbox,omitempty"`
+	DesignVCores            EntitlementStatus   `json:"vCoresDesign,omitempty"`
+	StaticIPs               EntitlementStatus   `json:"staticIps,omitempty"`
+	VPCs                    EntitlementStatus   `json:"vpcs,omitempty"`
+	LoadBalancer            EntitlementStatus   `json:"loadBalancer,omitempty"`
+	VPNs                    EntitlementStatus   `json:"vpns,omitempty"`
+	MQMessages              *EntitlementQuota   `json:"mqMessages,omitempty"`
+	MQRequests              *EntitlementQuota   `json:"mqRequests,omitempty"`
+	ObjectStoreRequestUnits *EntitlementQuota   `json:"objectStoreRequestUnits,omitempty"`
+	ObjectStoreKeys         *EntitlementQuota   `json:"objectStoreKeys,omitempty"`
+	RuntimeFabric           *bool               `json:"runtimeFabric,omitempty"`
+	APIManager              *EntitlementEnabled `json:"apis,omitempty"`
+}
+
+//EntitlementQuota is a metered entitlement (MQ, Object Store) made of a base allowance plus purchased add-ons
+type EntitlementQuota struct {
+	Base  float64 `json:"base"`
+	AddOn float64 `json:"addOn,omitempty"`
+}
+
+//Total returns the base allowance plus the add-ons. A missing quota has none
+func (e *EntitlementQuota) Total() float64 {
+	if e == nil {
+		return 0
+	}
+
+	return e.Base + e.AddOn
+}
+
+//EntitlementEnabled is an entitlement that is either granted to a business group or not
+type EntitlementEnabled struct {
+	Enabled bool `json:"enabled"`
 }
 
 type EntitlementStatus struct {
-	Assigned float64 `json:"assigned"`
+	Assigned   float64 `json:"assigned"`
+	Reassigned float64 `json:"reassigned,omitempty"`
 }
+
+//Available returns the part of the entitlement that has not been reassigned to sub organizations
+func (e EntitlementStatus) Available() float64 {
+	return e.Assigned - e.Reassigned
+}
+
 type BusinessGroup struct {
 	ID           string       `json:"id,omitempty"`
 	Name         string       `json:"name,omitempty"`
@@ -47,10 +80,25 @@ type BusinessGroup struct {
 	OwnerName             string          `json:"ownerName,omitempty"`
 	ParentOrganizationIDs []string        `json:"parentOrganizationIds,omitempty:"`
 	SessionTimeout        int             `json:"sessionTimeout,omitempty"`
+	MFARequired           bool            `json:"mfaRequired"`
 	SubOrganizations      []BusinessGroup `json:"subOrganizations,omitempty"`
 	TenantOrgIDs          []string        `json:"tenantOrganizationIds,omitempty"`
 }
 
+type Environment struct {
+	ID             string `json:"id,omitempty"`
+	Name           string `json:"name"`
+	OrganizationID string `json:"organizationId,omitempty"`
+	IsProduction   bool   `json:"isProduction"`
+	Type           string `json:"type,omitempty"`
+	ClientID       string `json:"clientId,omitempty"`
+}
+
+type environments struct {
+	Total int           `json:"total"`
+	Data  []Environment `json:"data"`
+}
+
 type Users struct {
 	Total int    `json:"total,omitempty"`
 	Data  []User `json:"data,omitempty"`
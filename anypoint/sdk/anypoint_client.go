@@ -5,7 +5,16 @@ import (
 )
 
 type AnypointClient struct {
-	AccessManagement *AccessManagement
+	AccessManagement   *AccessManagement
+	RuntimeManager     *RuntimeManager
+	ApplicationManager *ApplicationManager
+	RuntimeFabric      *RuntimeFabric
+	CloudHub           *CloudHub
+	APIManager         *APIManager
+	Exchange           *Exchange
+	AnypointMQ         *AnypointMQ
+	ObjectStore        *ObjectStore
+	SecretsManager     *SecretsManager
 }
 
 func NewAnypointClient(uri string, username, password string, insecure, httpWireLog bool) (*AnypointClient, error) {
@@ -17,5 +26,15 @@ func NewAnypointClient(uri string, username, password string, insecure, httpWire
 		return nil, fmt.Errorf("Error while creating a new instance of AnypointClient: %s", err)
 	}
 
+	ac.RuntimeManager = NewRuntimeManager(ac.AccessManagement, httpWireLog)
+	ac.ApplicationManager = NewApplicationManager(ac.AccessManagement)
+	ac.RuntimeFabric = NewRuntimeFabric(ac.AccessManagement)
+	ac.CloudHub = NewCloudHub(ac.AccessManagement, httpWireLog)
+	ac.APIManager = NewAPIManager(ac.AccessManagement)
+	ac.Exchange = NewExchange(ac.AccessManagement)
+	ac.AnypointMQ = NewAnypointMQ(ac.AccessManagement, httpWireLog)
+	ac.ObjectStore = NewObjectStore(ac.AccessManagement, httpWireLog)
+	ac.SecretsManager = NewSecretsManager(ac.AccessManagement)
+
 	return ac, nil
 }
@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func NewAPIManager(auth *AccessManagement) *APIManager {
+	return &APIManager{
+		auth: auth,
+	}
+}
+
+func (apim *APIManager) CreateAPIInstance(orgID, envID string, api APIInstance) (APIInstance, error) {
+	var response APIInstance
+
+	log.Printf("Creating API instance of asset [%s:%s]", api.Spec.AssetID, api.Spec.Version)
+
+	err := apim.auth.client.POST(api, apiInstancesPath(orgID, envID), &response)
+
+	if err != nil {
+		return APIInstance{}, fmt.Errorf("error while creating API instance of asset %s : %s", api.Spec.AssetID, err)
+	}
+
+	return response, nil
+}
+
+//GetAPIInstance returns the API instance with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (apim *APIManager) GetAPIInstance(orgID, envID string, apiID int) (APIInstance, error) {
+	var response APIInstance
+
+	err := apim.auth.client.GET(apiInstancePath(orgID, envID, apiID), &response)
+
+	if err != nil {
+		return APIInstance{}, err
+	}
+
+	return response, nil
+}
+
+func (apim *APIManager) UpdateAPIInstance(orgID, envID string, api APIInstance) (APIInstance, error) {
+	var response APIInstance
+
+	log.Printf("Updating API instance %d", api.ID)
+
+	err := apim.auth.client.PATCH(api, apiInstancePath(orgID, envID, api.ID), Application_Json, &response)
+
+	if err != nil {
+		return APIInstance{}, fmt.Errorf("error while updating API instance %d : %s", api.ID, err)
+	}
+
+	return response, nil
+}
+
+func (apim *APIManager) DeleteAPIInstance(orgID, envID string, apiID int) error {
+	resp := new(interface{})
+
+	err := apim.auth.client.DELETE(nil, apiInstancePath(orgID, envID, apiID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting API instance %d : %s", apiID, err)
+	}
+
+	return nil
+}
+
+//DeployAPIProxy deploys the proxy of the API instance to the target of the deployment
+func (apim *APIManager) DeployAPIProxy(orgID, envID string, apiID int, deployment APIDeployment) (APIDeployment, error) {
+	var response APIDeployment
+
+	log.Printf("Deploying the proxy of API instance %d to target %s", apiID, deployment.TargetID)
+
+	err := apim.auth.client.POST(deployment, apiDeploymentsPath(orgID, envID, apiID), &response)
+
+	if err != nil {
+		return APIDeployment{}, fmt.Errorf("error while deploying the proxy of API instance %d : %s", apiID, err)
+	}
+
+	return response, nil
+}
+
+//GetAPIDeployments returns the deployments of the proxy of the API instance. Errors are returned as they come
+//from the RestClient so that callers can check IsNotFound
+func (apim *APIManager) GetAPIDeployments(orgID, envID string, apiID int) ([]APIDeployment, error) {
+	var response []APIDeployment
+
+	err := apim.auth.client.GET(apiDeploymentsPath(orgID, envID, apiID), &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
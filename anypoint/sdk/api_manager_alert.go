@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func (apim *APIManager) CreateAPIAlert(orgID, envID string, apiID int, alert APIAlert) (APIAlert, error) {
+	var response APIAlert
+
+	log.Printf("Creating alert [%s] for API instance %d", alert.Name, apiID)
+
+	err := apim.auth.client.POST(alert, apiAlertsPath(orgID, envID, apiID), &response)
+
+	if err != nil {
+		return APIAlert{}, fmt.Errorf("error while creating alert %s for API instance %d : %s", alert.Name, apiID, err)
+	}
+
+	return response, nil
+}
+
+//GetAPIAlert returns the alert with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (apim *APIManager) GetAPIAlert(orgID, envID string, apiID int, alertID string) (APIAlert, error) {
+	var response APIAlert
+
+	err := apim.auth.client.GET(apiAlertPath(orgID, envID, apiID, alertID), &response)
+
+	if err != nil {
+		return APIAlert{}, err
+	}
+
+	return response, nil
+}
+
+func (apim *APIManager) UpdateAPIAlert(orgID, envID string, apiID int, alert APIAlert) (APIAlert, error) {
+	var response APIAlert
+
+	log.Printf("Updating alert %s of API instance %d", alert.ID, apiID)
+
+	err := apim.auth.client.PUT(alert, apiAlertPath(orgID, envID, apiID, alert.ID), &response)
+
+	if err != nil {
+		return APIAlert{}, fmt.Errorf("error while updating alert %s of API instance %d : %s", alert.ID, apiID, err)
+	}
+
+	return response, nil
+}
+
+func (apim *APIManager) DeleteAPIAlert(orgID, envID string, apiID int, alertID string) error {
+	resp := new(interface{})
+
+	err := apim.auth.client.DELETE(nil, apiAlertPath(orgID, envID, apiID, alertID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting alert %s of API instance %d : %s", alertID, apiID, err)
+	}
+
+	return nil
+}
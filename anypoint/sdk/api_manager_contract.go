@@ -0,0 +1,59 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+//GetContract returns the contract with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (apim *APIManager) GetContract(orgID, envID string, apiID, contractID int) (Contract, error) {
+	var response Contract
+
+	err := apim.auth.client.GET(apiContractPath(orgID, envID, apiID, contractID), &response)
+
+	if err != nil {
+		return Contract{}, err
+	}
+
+	return response, nil
+}
+
+func (apim *APIManager) ApproveContract(orgID, envID string, apiID, contractID int) (Contract, error) {
+	return apim.contractAction(orgID, envID, apiID, contractID, "approve")
+}
+
+func (apim *APIManager) RevokeContract(orgID, envID string, apiID, contractID int) (Contract, error) {
+	return apim.contractAction(orgID, envID, apiID, contractID, "revoke")
+}
+
+func (apim *APIManager) RejectContract(orgID, envID string, apiID, contractID int) (Contract, error) {
+	return apim.contractAction(orgID, envID, apiID, contractID, "reject")
+}
+
+//DeleteContract deletes the contract. Only revoked or rejected contracts can be deleted
+func (apim *APIManager) DeleteContract(orgID, envID string, apiID, contractID int) error {
+	resp := new(interface{})
+
+	err := apim.auth.client.DELETE(nil, apiContractPath(orgID, envID, apiID, contractID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting contract %d of API instance %d : %s", contractID, apiID, err)
+	}
+
+	return nil
+}
+
+func (apim *APIManager) contractAction(orgID, envID string, apiID, contractID int, action string) (Contract, error) {
+	var response Contract
+
+	log.Printf("Sending %s to contract %d of API instance %d", action, contractID, apiID)
+
+	err := apim.auth.client.POST(nil, apiContractPath(orgID, envID, apiID, contractID)+"/"+action, &response)
+
+	if err != nil {
+		return Contract{}, fmt.Errorf("error while sending %s to contract %d of API instance %d : %s", action, contractID, apiID, err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,62 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func (apim *APIManager) CreateAPIPolicy(orgID, envID string, apiID int, policy APIPolicy) (APIPolicy, error) {
+	var response APIPolicy
+
+	log.Printf("Applying policy [%s:%s] to API instance %d", policy.AssetID, policy.AssetVersion, apiID)
+
+	err := apim.auth.client.POST(policy, apiPoliciesPath(orgID, envID, apiID), &response)
+
+	if err != nil {
+		return APIPolicy{}, fmt.Errorf("error while applying policy %s to API instance %d : %s", policy.AssetID, apiID, err)
+	}
+
+	return response, nil
+}
+
+//GetAPIPolicy returns the policy with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (apim *APIManager) GetAPIPolicy(orgID, envID string, apiID, policyID int) (APIPolicy, error) {
+	var response APIPolicy
+
+	err := apim.auth.client.GET(apiPolicyPath(orgID, envID, apiID, policyID), &response)
+
+	if err != nil {
+		return APIPolicy{}, err
+	}
+
+	return response, nil
+}
+
+//UpdateAPIPolicy updates the configuration, pointcuts and order of the policy. Changing the order
+//moves the other policies of the API instance accordingly
+func (apim *APIManager) UpdateAPIPolicy(orgID, envID string, apiID int, policy APIPolicy) (APIPolicy, error) {
+	var response APIPolicy
+
+	log.Printf("Updating policy %d of API instance %d", policy.ID, apiID)
+
+	err := apim.auth.client.PATCH(policy, apiPolicyPath(orgID, envID, apiID, policy.ID), Application_Json, &response)
+
+	if err != nil {
+		return APIPolicy{}, fmt.Errorf("error while updating policy %d of API instance %d : %s", policy.ID, apiID, err)
+	}
+
+	return response, nil
+}
+
+func (apim *APIManager) DeleteAPIPolicy(orgID, envID string, apiID, policyID int) error {
+	resp := new(interface{})
+
+	err := apim.auth.client.DELETE(nil, apiPolicyPath(orgID, envID, apiID, policyID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while removing policy %d from API instance %d : %s", policyID, apiID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func (apim *APIManager) CreateSLATier(orgID, envID string, apiID int, tier SLATier) (SLATier, error) {
+	var response SLATier
+
+	log.Printf("Creating SLA tier [%s] for API instance %d", tier.Name, apiID)
+
+	err := apim.auth.client.POST(tier, apiTiersPath(orgID, envID, apiID), &response)
+
+	if err != nil {
+		return SLATier{}, fmt.Errorf("error while creating SLA tier %s for API instance %d : %s", tier.Name, apiID, err)
+	}
+
+	return response, nil
+}
+
+//GetSLATier returns the SLA tier with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (apim *APIManager) GetSLATier(orgID, envID string, apiID, tierID int) (SLATier, error) {
+	var response SLATier
+
+	err := apim.auth.client.GET(apiTierPath(orgID, envID, apiID, tierID), &response)
+
+	if err != nil {
+		return SLATier{}, err
+	}
+
+	return response, nil
+}
+
+func (apim *APIManager) UpdateSLATier(orgID, envID string, apiID int, tier SLATier) (SLATier, error) {
+	var response SLATier
+
+	log.Printf("Updating SLA tier %d of API instance %d", tier.ID, apiID)
+
+	err := apim.auth.client.PUT(tier, apiTierPath(orgID, envID, apiID, tier.ID), &response)
+
+	if err != nil {
+		return SLATier{}, fmt.Errorf("error while updating SLA tier %d of API instance %d : %s", tier.ID, apiID, err)
+	}
+
+	return response, nil
+}
+
+func (apim *APIManager) DeleteSLATier(orgID, envID string, apiID, tierID int) error {
+	resp := new(interface{})
+
+	err := apim.auth.client.DELETE(nil, apiTierPath(orgID, envID, apiID, tierID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting SLA tier %d of API instance %d : %s", tierID, apiID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package sdk
+
+const (
+	DeploymentTypeCloudHub      = "CH"
+	DeploymentTypeHybrid        = "HY"
+	DeploymentTypeRuntimeFabric = "RF"
+
+	//MuleSoftPolicyGroupID is the Exchange group of the policy templates provided by MuleSoft
+	MuleSoftPolicyGroupID = "68ef9520-24e9-4cf2-b2f5-620025690913"
+
+	APIAlertTypeResponseCode    = "api-response-code"
+	APIAlertTypeResponseTime    = "api-response-time"
+	APIAlertTypePolicyViolation = "api-policy-violation"
+
+	APIAlertRecipientUser  = "user"
+	APIAlertRecipientEmail = "email"
+
+	SLATierStatusActive     = "ACTIVE"
+	SLATierStatusDeprecated = "DEPRECATED"
+
+	ContractStatusPending  = "PENDING"
+	ContractStatusApproved = "APPROVED"
+	ContractStatusRevoked  = "REVOKED"
+)
+
+//APIManager manages API instances and everything attached to them in API Manager
+type APIManager struct {
+	auth *AccessManagement
+}
+
+//APISpec are the Exchange coordinates of the asset the API instance is created from
+type APISpec struct {
+	GroupID string `json:"groupId"`
+	AssetID string `json:"assetId"`
+	Version string `json:"version"`
+}
+
+type APIInstance struct {
+	ID                        int       `json:"id,omitempty"`
+	Spec                      *APISpec  `json:"spec,omitempty"`
+	GroupID                   string    `json:"groupId,omitempty"`
+	AssetID                   string    `json:"assetId,omitempty"`
+	AssetVersion              string    `json:"assetVersion,omitempty"`
+	InstanceLabel             string    `json:"instanceLabel,omitempty"`
+	Technology                string    `json:"technology,omitempty"`
+	Status                    string    `json:"status,omitempty"`
+	AutodiscoveryInstanceName string    `json:"autodiscoveryInstanceName,omitempty"`
+	Endpoint                  *Endpoint `json:"endpoint,omitempty"`
+}
+
+//APIDeployment deploys the proxy of an API instance to a server, cluster or fabric
+type APIDeployment struct {
+	ID              int    `json:"id,omitempty"`
+	EnvironmentID   string `json:"environmentId"`
+	Type            string `json:"type"`
+	ExpectedStatus  string `json:"expectedStatus"`
+	TargetID        string `json:"targetId"`
+	TargetName      string `json:"targetName,omitempty"`
+	ApplicationName string `json:"applicationName,omitempty"`
+}
+
+//APIPolicy is a policy template applied to an API instance. Policies are executed following their order
+type APIPolicy struct {
+	ID                int                    `json:"id,omitempty"`
+	PolicyTemplateID  string                 `json:"policyTemplateId,omitempty"`
+	GroupID           string                 `json:"groupId,omitempty"`
+	AssetID           string                 `json:"assetId,omitempty"`
+	AssetVersion      string                 `json:"assetVersion,omitempty"`
+	ConfigurationData map[string]interface{} `json:"configurationData"`
+	PointcutData      []PolicyPointcut       `json:"pointcutData"`
+	Order             int                    `json:"order,omitempty"`
+	Disabled          bool                   `json:"disabled"`
+}
+
+//PolicyPointcut restricts a policy to the resources matching the regular expressions.
+//MethodRegex is a list of methods separated by |. Example: GET|POST
+type PolicyPointcut struct {
+	MethodRegex      string `json:"methodRegex"`
+	URITemplateRegex string `json:"uriTemplateRegex"`
+}
+
+//SLATier groups the rate limits granted to the client applications requesting access to an API instance
+type SLATier struct {
+	ID          int        `json:"id,omitempty"`
+	APIID       int        `json:"apiId,omitempty"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	AutoApprove bool       `json:"autoApprove"`
+	Status      string     `json:"status"`
+	Limits      []SLALimit `json:"limits"`
+}
+
+type SLALimit struct {
+	MaximumRequests          int  `json:"maximumRequests"`
+	TimePeriodInMilliseconds int  `json:"timePeriodInMilliseconds"`
+	Visible                  bool `json:"visible"`
+}
+
+//Contract grants a client application access to an API instance, optionally within an SLA tier
+type Contract struct {
+	ID              int    `json:"id,omitempty"`
+	APIID           int    `json:"apiId"`
+	EnvironmentID   string `json:"environmentId"`
+	OrganizationID  string `json:"organizationId,omitempty"`
+	InstanceType    string `json:"instanceType,omitempty"`
+	GroupID         string `json:"groupId,omitempty"`
+	AssetID         string `json:"assetId,omitempty"`
+	Version         string `json:"version,omitempty"`
+	VersionGroup    string `json:"versionGroup,omitempty"`
+	RequestedTierID int    `json:"requestedTierId,omitempty"`
+	TierID          int    `json:"tierId,omitempty"`
+	ApplicationID   int    `json:"applicationId,omitempty"`
+	AcceptedTerms   bool   `json:"acceptedTerms"`
+	Status          string `json:"status,omitempty"`
+}
+
+//APIAlert notifies the recipients when the condition of its type is met during RepeatCount consecutive
+//periods of Period minutes. Severities are the AlertSeverity constants shared with runtime alerts
+type APIAlert struct {
+	ID          string              `json:"id,omitempty"`
+	Name        string              `json:"name"`
+	Type        string              `json:"type"`
+	Severity    string              `json:"severity"`
+	Enabled     bool                `json:"enabled"`
+	Condition   APIAlertCondition   `json:"condition"`
+	Period      int                 `json:"period"`
+	RepeatCount int                 `json:"repeat"`
+	Recipients  []APIAlertRecipient `json:"recipients"`
+}
+
+//APIAlertCondition holds the threshold of the alert: a number of requests for response codes and policy
+//violations, milliseconds for response times
+type APIAlertCondition struct {
+	Operator      string   `json:"operator"`
+	Threshold     int      `json:"threshold"`
+	ResponseCodes []string `json:"responseCodes,omitempty"`
+	PolicyID      int      `json:"policyId,omitempty"`
+}
+
+//APIAlertRecipient is either the ID of an Anypoint user or an email address
+type APIAlertRecipient struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,96 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func NewApplicationManager(auth *AccessManagement) *ApplicationManager {
+	return &ApplicationManager{
+		auth: auth,
+	}
+}
+
+//NewDeploymentConfiguration wraps properties into the configuration expected by the Mule agent
+func NewDeploymentConfiguration(appName string, properties, secureProperties map[string]string) map[string]DeploymentConfiguration {
+	return map[string]DeploymentConfiguration{
+		propertiesServiceKey: {
+			ApplicationName:  appName,
+			Properties:       properties,
+			SecureProperties: secureProperties,
+		},
+	}
+}
+
+//Properties returns the application properties of the deployment. Secure properties are never
+//returned in clear by the platform
+func (app DeploymentApplication) Properties() map[string]string {
+	return app.Configuration[propertiesServiceKey].Properties
+}
+
+func (am *ApplicationManager) CreateDeployment(orgID, envID string, deployment Deployment) (Deployment, error) {
+	var response Deployment
+
+	log.Printf("Creating deployment [%s] on target %s", deployment.Name, deployment.Target.TargetID)
+
+	err := am.auth.client.POST(deployment, amcDeploymentsPath(orgID, envID), &response)
+
+	if err != nil {
+		return Deployment{}, fmt.Errorf("error while creating deployment %s : %s", deployment.Name, err)
+	}
+
+	return response, nil
+}
+
+//ListDeployments returns the CloudHub 2.0 and Runtime Fabric deployments of an environment
+func (am *ApplicationManager) ListDeployments(orgID, envID string) ([]Deployment, error) {
+	var response deployments
+
+	err := am.auth.client.GET(amcDeploymentsPath(orgID, envID), &response)
+
+	if err != nil {
+		return nil, fmt.Errorf("error while listing deployments of environment %s : %s", envID, err)
+	}
+
+	return response.Items, nil
+}
+
+//GetDeployment returns the deployment with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (am *ApplicationManager) GetDeployment(orgID, envID, deploymentID string) (Deployment, error) {
+	var response Deployment
+
+	err := am.auth.client.GET(amcDeploymentPath(orgID, envID, deploymentID), &response)
+
+	if err != nil {
+		return Deployment{}, err
+	}
+
+	return response, nil
+}
+
+func (am *ApplicationManager) UpdateDeployment(orgID, envID string, deployment Deployment) (Deployment, error) {
+	var response Deployment
+
+	log.Printf("Updating deployment [%s] (%s)", deployment.Name, deployment.ID)
+
+	err := am.auth.client.PATCH(deployment, amcDeploymentPath(orgID, envID, deployment.ID), Application_Json, &response)
+
+	if err != nil {
+		return Deployment{}, fmt.Errorf("error while updating deployment %s : %s", deployment.Name, err)
+	}
+
+	return response, nil
+}
+
+func (am *ApplicationManager) DeleteDeployment(orgID, envID, deploymentID string) error {
+	resp := new(interface{})
+
+	err := am.auth.client.DELETE(nil, amcDeploymentPath(orgID, envID, deploymentID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting deployment with ID %s : %s", deploymentID, err)
+	}
+
+	return nil
+}
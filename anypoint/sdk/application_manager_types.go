@@ -0,0 +1,76 @@
+package sdk
+
+const (
+	DeploymentStatusApplied  = "APPLIED"
+	DeploymentStatusApplying = "APPLYING"
+	DeploymentStatusFailed   = "FAILED"
+
+	ApplicationStatusRunning = "RUNNING"
+	ApplicationStateStarted  = "STARTED"
+
+	TargetProviderMC = "MC"
+)
+
+//ApplicationManager manages CloudHub 2.0 and Runtime Fabric deployments through the AMC application manager API
+type ApplicationManager struct {
+	auth *AccessManagement
+}
+
+type deployments struct {
+	Total int          `json:"total"`
+	Items []Deployment `json:"items"`
+}
+
+type Deployment struct {
+	ID             string                `json:"id,omitempty"`
+	Name           string                `json:"name"`
+	Status         string                `json:"status,omitempty"`
+	DesiredVersion string                `json:"desiredVersion,omitempty"`
+	Target         DeploymentTarget      `json:"target"`
+	Application    DeploymentApplication `json:"application"`
+}
+
+//DeploymentTarget is a shared space (e.g. cloudhub-us-east-1), a private space or a Runtime Fabric
+type DeploymentTarget struct {
+	Provider           string             `json:"provider"`
+	TargetID           string             `json:"targetId"`
+	Replicas           int                `json:"replicas"`
+	DeploymentSettings DeploymentSettings `json:"deploymentSettings"`
+}
+
+type DeploymentSettings struct {
+	Clustered      bool           `json:"clustered"`
+	RuntimeVersion string         `json:"runtimeVersion"`
+	UpdateStrategy string         `json:"updateStrategy,omitempty"`
+	HTTP           DeploymentHTTP `json:"http"`
+}
+
+type DeploymentHTTP struct {
+	Inbound DeploymentInbound `json:"inbound"`
+}
+
+type DeploymentInbound struct {
+	PublicURL string `json:"publicUrl,omitempty"`
+}
+
+type DeploymentApplication struct {
+	Ref           DeploymentRef                      `json:"ref"`
+	DesiredState  string                             `json:"desiredState,omitempty"`
+	Status        string                             `json:"status,omitempty"`
+	VCores        float64                            `json:"vCores"`
+	Configuration map[string]DeploymentConfiguration `json:"configuration,omitempty"`
+}
+
+//DeploymentRef are the Exchange coordinates of the deployed asset
+type DeploymentRef struct {
+	GroupID    string `json:"groupId"`
+	ArtifactID string `json:"artifactId"`
+	Version    string `json:"version"`
+	Packaging  string `json:"packaging"`
+}
+
+type DeploymentConfiguration struct {
+	ApplicationName  string            `json:"applicationName,omitempty"`
+	Properties       map[string]string `json:"properties,omitempty"`
+	SecureProperties map[string]string `json:"secureProperties,omitempty"`
+}
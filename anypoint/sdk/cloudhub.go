@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func NewCloudHub(auth *AccessManagement, httpWireLog bool) *CloudHub {
+	return &CloudHub{
+		auth:        auth,
+		httpWireLog: httpWireLog,
+	}
+}
+
+func (ch *CloudHub) ListVPCs(orgID string) ([]VPC, error) {
+	var response vpcs
+
+	err := ch.auth.client.GET(vpcsPath(orgID), &response)
+
+	if err != nil {
+		return nil, fmt.Errorf("error while listing the VPCs of business group %s : %s", orgID, err)
+	}
+
+	return response.Data, nil
+}
+
+func (ch *CloudHub) CreateVPC(orgID string, vpc VPC) (VPC, error) {
+	var response VPC
+
+	log.Printf("Creating VPC [%s] in %s", vpc.Name, vpc.Region)
+
+	err := ch.auth.client.POST(vpc, vpcsPath(orgID), &response)
+
+	if err != nil {
+		return VPC{}, fmt.Errorf("error while creating VPC %s : %s", vpc.Name, err)
+	}
+
+	return response, nil
+}
+
+//GetVPC returns the VPC with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (ch *CloudHub) GetVPC(orgID, vpcID string) (VPC, error) {
+	var response VPC
+
+	err := ch.auth.client.GET(vpcPath(orgID, vpcID), &response)
+
+	if err != nil {
+		return VPC{}, err
+	}
+
+	return response, nil
+}
+
+func (ch *CloudHub) UpdateVPC(orgID string, vpc VPC) (VPC, error) {
+	var response VPC
+
+	log.Printf("Updating VPC [%s] (%s)", vpc.Name, vpc.ID)
+
+	err := ch.auth.client.PUT(vpc, vpcPath(orgID, vpc.ID), &response)
+
+	if err != nil {
+		return VPC{}, fmt.Errorf("error while updating VPC %s : %s", vpc.Name, err)
+	}
+
+	return response, nil
+}
+
+func (ch *CloudHub) DeleteVPC(orgID, vpcID string) error {
+	resp := new(interface{})
+
+	err := ch.auth.client.DELETE(nil, vpcPath(orgID, vpcID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting VPC with ID %s : %s", vpcID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+//ListApplications returns the CloudHub 1.0 applications of an environment
+func (ch *CloudHub) ListApplications(orgID, envID string) ([]CloudHubApplication, error) {
+	var response []CloudHubApplication
+
+	err := ch.armClient(orgID, envID).GET(CLOUDHUB_APPLICATIONS, &response)
+
+	if err != nil {
+		return nil, fmt.Errorf("error while listing CloudHub applications of environment %s : %s", envID, err)
+	}
+
+	return response, nil
+}
+
+func (ch *CloudHub) DeleteApplication(orgID, envID, domain string) error {
+	resp := new(interface{})
+
+	log.Printf("Deleting CloudHub application %s", domain)
+	err := ch.armClient(orgID, envID).DELETE(nil, cloudHubApplicationPath(domain), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting CloudHub application %s : %s", domain, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func (ch *CloudHub) CreateLoadBalancer(orgID string, lb LoadBalancer) (LoadBalancer, error) {
+	var response LoadBalancer
+
+	log.Printf("Creating dedicated load balancer [%s] in VPC %s", lb.Name, lb.VpcID)
+
+	err := ch.auth.client.POST(lb, loadBalancersPath(orgID, lb.VpcID), &response)
+
+	if err != nil {
+		return LoadBalancer{}, fmt.Errorf("error while creating dedicated load balancer %s : %s", lb.Name, err)
+	}
+
+	return response, nil
+}
+
+//GetLoadBalancer returns the load balancer with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (ch *CloudHub) GetLoadBalancer(orgID, vpcID, lbID string) (LoadBalancer, error) {
+	var response LoadBalancer
+
+	err := ch.auth.client.GET(loadBalancerPath(orgID, vpcID, lbID), &response)
+
+	if err != nil {
+		return LoadBalancer{}, err
+	}
+
+	return response, nil
+}
+
+//PatchLoadBalancer applies the given JSON patch operations to the load balancer in place
+func (ch *CloudHub) PatchLoadBalancer(orgID, vpcID, lbID string, ops []PatchOperation) (LoadBalancer, error) {
+	var response LoadBalancer
+
+	log.Printf("Updating dedicated load balancer %s", lbID)
+
+	err := ch.auth.client.PATCH(ops, loadBalancerPath(orgID, vpcID, lbID), Application_Json, &response)
+
+	if err != nil {
+		return LoadBalancer{}, fmt.Errorf("error while updating dedicated load balancer %s : %s", lbID, err)
+	}
+
+	return response, nil
+}
+
+func (ch *CloudHub) DeleteLoadBalancer(orgID, vpcID, lbID string) error {
+	resp := new(interface{})
+
+	err := ch.auth.client.DELETE(nil, loadBalancerPath(orgID, vpcID, lbID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting dedicated load balancer with ID %s : %s", lbID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+//armClient returns a client scoped to the environment, as required by the environment level CloudHub APIs
+func (ch *CloudHub) armClient(orgID, envID string) *RestClient {
+	return ch.auth.GetARMAuthenticatedHttpClient(orgID, envID, ch.httpWireLog)
+}
+
+//AllocateStaticIP reserves a new static IP in the given region of the environment
+func (ch *CloudHub) AllocateStaticIP(orgID, envID, region string) (StaticIP, error) {
+	var response StaticIP
+
+	log.Printf("Allocating a static IP in %s", region)
+
+	err := ch.armClient(orgID, envID).POST(StaticIP{Region: region}, STATIC_IPS, &response)
+
+	if err != nil {
+		return StaticIP{}, fmt.Errorf("error while allocating a static IP in %s : %s", region, err)
+	}
+
+	return response, nil
+}
+
+//GetStaticIP returns the static IP with the given address. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (ch *CloudHub) GetStaticIP(orgID, envID, address string) (StaticIP, error) {
+	var response StaticIP
+
+	err := ch.armClient(orgID, envID).GET(staticIPPath(address), &response)
+
+	if err != nil {
+		return StaticIP{}, err
+	}
+
+	return response, nil
+}
+
+//AssignStaticIP binds the static IP to the application with the given domain. An empty domain unbinds it
+func (ch *CloudHub) AssignStaticIP(orgID, envID, address, appDomain string) (StaticIP, error) {
+	var response StaticIP
+
+	log.Printf("Assigning static IP %s to application [%s]", address, appDomain)
+
+	err := ch.armClient(orgID, envID).PUT(StaticIP{ApplicationDomain: appDomain}, staticIPPath(address), &response)
+
+	if err != nil {
+		return StaticIP{}, fmt.Errorf("error while assigning static IP %s to %s : %s", address, appDomain, err)
+	}
+
+	return response, nil
+}
+
+func (ch *CloudHub) ReleaseStaticIP(orgID, envID, address string) error {
+	resp := new(interface{})
+
+	err := ch.armClient(orgID, envID).DELETE(nil, staticIPPath(address), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while releasing static IP %s : %s", address, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package sdk
+
+//CloudHub manages the CloudHub 1.0 networking resources drawn from the business group entitlements
+type CloudHub struct {
+	auth        *AccessManagement
+	httpWireLog bool
+}
+
+type VPC struct {
+	ID                     string            `json:"id,omitempty"`
+	Name                   string            `json:"name"`
+	Region                 string            `json:"region"`
+	CidrBlock              string            `json:"cidrBlock"`
+	IsDefault              bool              `json:"isDefault"`
+	OwnerID                string            `json:"ownerId,omitempty"`
+	InternalDNS            VPCInternalDNS    `json:"internalDns"`
+	AssociatedEnvironments []string          `json:"associatedEnvironments"`
+	SharedWith             []string          `json:"sharedWith"`
+	FirewallRules          []VPCFirewallRule `json:"firewallRules"`
+}
+
+//VPCInternalDNS are the DNS servers used to resolve the special domains from within the VPC
+type VPCInternalDNS struct {
+	DNSServers     []string `json:"dnsServers"`
+	SpecialDomains []string `json:"specialDomains"`
+}
+
+type VPCFirewallRule struct {
+	CidrBlock string `json:"cidrBlock"`
+	Protocol  string `json:"protocol"`
+	FromPort  int    `json:"fromPort"`
+	ToPort    int    `json:"toPort"`
+}
+
+type vpcs struct {
+	Data  []VPC `json:"data"`
+	Total int   `json:"total"`
+}
+
+const (
+	PatchOpReplace = "replace"
+)
+
+type LoadBalancer struct {
+	ID                 string        `json:"id,omitempty"`
+	Name               string        `json:"name"`
+	VpcID              string        `json:"vpcId,omitempty"`
+	Domain             string        `json:"domain,omitempty"`
+	State              string        `json:"state,omitempty"`
+	IPWhitelist        []string      `json:"ipWhitelist"`
+	HTTPMode           string        `json:"httpMode"`
+	TLSv1              bool          `json:"tlsv1"`
+	UpstreamTLSv12     bool          `json:"upstreamTlsv12"`
+	KeepURLEncoding    bool          `json:"keepUrlEncoding"`
+	DefaultSSLEndpoint int           `json:"defaultSslEndpoint"`
+	Workers            int           `json:"workers,omitempty"`
+	SSLEndpoints       []SSLEndpoint `json:"sslEndpoints"`
+}
+
+//SSLEndpoint is a certificate served by the load balancer together with the mapping rules applied
+//to the requests it terminates
+type SSLEndpoint struct {
+	PublicKey        string                `json:"publicKey,omitempty"`
+	PrivateKey       string                `json:"privateKey,omitempty"`
+	PublicKeyCN      string                `json:"publicKeyCN,omitempty"`
+	PublicKeyDigest  string                `json:"publicKeyDigest,omitempty"`
+	PrivateKeyDigest string                `json:"privateKeyDigest,omitempty"`
+	VerifyClientMode string                `json:"verifyClientMode,omitempty"`
+	Mappings         []LoadBalancerMapping `json:"mappings"`
+}
+
+//LoadBalancerMapping routes the requests matching InputURI to an application. Patterns may use the
+//{app} and {version} placeholders
+type LoadBalancerMapping struct {
+	InputURI         string `json:"inputUri"`
+	AppName          string `json:"appName"`
+	AppURI           string `json:"appUri"`
+	UpstreamProtocol string `json:"upstreamProtocol"`
+	UpstreamPort     int    `json:"upstreamPort,omitempty"`
+}
+
+//PatchOperation is a JSON patch operation, used by the CloudHub API for in place updates
+type PatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value"`
+}
+
+//VPNConnection is a site-to-site IPsec VPN attached to a VPC
+type VPNConnection struct {
+	ID         string      `json:"id,omitempty"`
+	Name       string      `json:"name"`
+	State      string      `json:"state,omitempty"`
+	Spec       VPNSpec     `json:"spec"`
+	VPNTunnels []VPNTunnel `json:"vpnTunnels,omitempty"`
+}
+
+//VPNSpec uses static routing when RemoteNetworks is set and BGP when RemoteAsn is set
+type VPNSpec struct {
+	RemoteIPAddress string            `json:"remoteIpAddress"`
+	RemoteNetworks  []string          `json:"remoteNetworks,omitempty"`
+	RemoteAsn       int               `json:"remoteAsn,omitempty"`
+	LocalAsn        int               `json:"localAsn,omitempty"`
+	IkeVersion      string            `json:"ikeVersion,omitempty"`
+	TunnelConfigs   []VPNTunnelConfig `json:"tunnelConfigs"`
+}
+
+type VPNTunnelConfig struct {
+	Psk           string `json:"psk,omitempty"`
+	PtpCidr       string `json:"ptpCidr,omitempty"`
+	StartupAction string `json:"startupAction,omitempty"`
+}
+
+//VPNTunnel is the status of one of the tunnels of the VPN as reported by the platform
+type VPNTunnel struct {
+	Status             string `json:"status"`
+	StatusMessage      string `json:"statusMessage"`
+	AcceptedRouteCount int    `json:"acceptedRouteCount"`
+}
+
+//StaticIP is an IP allocated in a region for an environment, optionally bound to an application
+type StaticIP struct {
+	Address           string `json:"address,omitempty"`
+	Region            string `json:"region,omitempty"`
+	ApplicationDomain string `json:"applicationDomain"`
+	Status            string `json:"status,omitempty"`
+}
+
+//CloudHubApplication is an application deployed to CloudHub 1.0 workers
+type CloudHubApplication struct {
+	Domain     string `json:"domain"`
+	FullDomain string `json:"fullDomain,omitempty"`
+	Status     string `json:"status,omitempty"`
+	Region     string `json:"region,omitempty"`
+}
@@ -0,0 +1,58 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func (ch *CloudHub) ListVPNs(orgID, vpcID string) ([]VPNConnection, error) {
+	var response []VPNConnection
+
+	err := ch.auth.client.GET(vpnsPath(orgID, vpcID), &response)
+
+	if err != nil {
+		return nil, fmt.Errorf("error while listing the VPNs of VPC %s : %s", vpcID, err)
+	}
+
+	return response, nil
+}
+
+func (ch *CloudHub) CreateVPN(orgID, vpcID string, vpn VPNConnection) (VPNConnection, error) {
+	var response VPNConnection
+
+	log.Printf("Creating VPN [%s] in VPC %s", vpn.Name, vpcID)
+
+	err := ch.auth.client.POST(vpn, vpnsPath(orgID, vpcID), &response)
+
+	if err != nil {
+		return VPNConnection{}, fmt.Errorf("error while creating VPN %s : %s", vpn.Name, err)
+	}
+
+	return response, nil
+}
+
+//GetVPN returns the VPN with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (ch *CloudHub) GetVPN(orgID, vpcID, vpnID string) (VPNConnection, error) {
+	var response VPNConnection
+
+	err := ch.auth.client.GET(vpnPath(orgID, vpcID, vpnID), &response)
+
+	if err != nil {
+		return VPNConnection{}, err
+	}
+
+	return response, nil
+}
+
+func (ch *CloudHub) DeleteVPN(orgID, vpcID, vpnID string) error {
+	resp := new(interface{})
+
+	err := ch.auth.client.DELETE(nil, vpnPath(orgID, vpcID, vpnID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting VPN with ID %s : %s", vpnID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func NewExchange(auth *AccessManagement) *Exchange {
+	return &Exchange{
+		auth: auth,
+	}
+}
+
+func (ex *Exchange) CreateClientApplication(orgID string, app ClientApplication) (ClientApplication, error) {
+	var response ClientApplication
+
+	log.Printf("Creating client application [%s]", app.Name)
+
+	err := ex.auth.client.POST(app, clientApplicationsPath(orgID), &response)
+
+	if err != nil {
+		return ClientApplication{}, fmt.Errorf("error while creating client application %s : %s", app.Name, err)
+	}
+
+	return response, nil
+}
+
+//GetClientApplication returns the client application with the given ID, credentials included. Errors are
+//returned as they come from the RestClient so that callers can check IsNotFound
+func (ex *Exchange) GetClientApplication(orgID string, appID int) (ClientApplication, error) {
+	var response ClientApplication
+
+	err := ex.auth.client.GET(clientApplicationPath(orgID, appID), &response)
+
+	if err != nil {
+		return ClientApplication{}, err
+	}
+
+	return response, nil
+}
+
+func (ex *Exchange) UpdateClientApplication(orgID string, app ClientApplication) (ClientApplication, error) {
+	var response ClientApplication
+
+	log.Printf("Updating client application %d", app.ID)
+
+	err := ex.auth.client.PUT(app, clientApplicationPath(orgID, app.ID), &response)
+
+	if err != nil {
+		return ClientApplication{}, fmt.Errorf("error while updating client application %d : %s", app.ID, err)
+	}
+
+	return response, nil
+}
+
+func (ex *Exchange) DeleteClientApplication(orgID string, appID int) error {
+	resp := new(interface{})
+
+	err := ex.auth.client.DELETE(nil, clientApplicationPath(orgID, appID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting client application %d : %s", appID, err)
+	}
+
+	return nil
+}
+
+//RequestContract requests access to an API instance on behalf of the client application. The contract
+//stays pending until approved, unless the requested tier is auto approved
+func (ex *Exchange) RequestContract(orgID string, appID int, contract Contract) (Contract, error) {
+	var response Contract
+
+	log.Printf("Requesting access to API instance %d for client application %d", contract.APIID, appID)
+
+	err := ex.auth.client.POST(contract, clientApplicationContractsPath(orgID, appID), &response)
+
+	if err != nil {
+		return Contract{}, fmt.Errorf("error while requesting access to API instance %d for client application %d : %s", contract.APIID, appID, err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,170 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+//PublishAsset uploads a new version of an asset. The file is sent under the classifier of the asset,
+//HTTP assets have no file
+func (ex *Exchange) PublishAsset(asset ExchangeAsset) (ExchangeAsset, error) {
+	var response ExchangeAsset
+
+	body := MultipartBody{
+		Fields: map[string]string{
+			"organizationId": asset.GroupID,
+			"groupId":        asset.GroupID,
+			"assetId":        asset.AssetID,
+			"version":        asset.Version,
+			"name":           asset.Name,
+			"classifier":     asset.Classifier,
+		},
+	}
+
+	if asset.APIVersion != "" {
+		body.Fields["apiVersion"] = asset.APIVersion
+	}
+
+	if asset.Main != "" {
+		body.Fields["main"] = asset.Main
+	}
+
+	if asset.FilePath != "" {
+		file, err := os.Open(asset.FilePath)
+		if err != nil {
+			return ExchangeAsset{}, fmt.Errorf("unable to open %s : %s", asset.FilePath, err)
+		}
+		defer file.Close()
+
+		body.Files = []MultipartFile{
+			{
+				Param:       "asset",
+				FileName:    filepath.Base(asset.FilePath),
+				ContentType: Application_OctetStream,
+				Reader:      file,
+			},
+		}
+	}
+
+	log.Printf("Publishing asset [%s:%s:%s]", asset.GroupID, asset.AssetID, asset.Version)
+
+	err := ex.auth.client.POSTWithContentType(body, EXCHANGE_PUBLISH, Multipart_Form_Data, &response)
+
+	if err != nil {
+		return ExchangeAsset{}, fmt.Errorf("error while publishing asset %s:%s:%s : %s", asset.GroupID, asset.AssetID, asset.Version, err)
+	}
+
+	return response, nil
+}
+
+//GetAsset returns the given version of an asset. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (ex *Exchange) GetAsset(groupID, assetID, version string) (ExchangeAsset, error) {
+	var response ExchangeAsset
+
+	err := ex.auth.client.GET(exchangeAssetPath(EXCHANGE_ASSET, groupID, assetID, version), &response)
+
+	if err != nil {
+		return ExchangeAsset{}, err
+	}
+
+	return response, nil
+}
+
+//GetAssetVersions returns the versions of an asset, deprecated ones included
+func (ex *Exchange) GetAssetVersions(groupID, assetID string) ([]ExchangeAsset, error) {
+	var response ExchangeAsset
+
+	err := ex.auth.client.GET(exchangeAssetPath(EXCHANGE_ASSET_LATEST, groupID, assetID, ""), &response)
+
+	if err != nil {
+		return nil, fmt.Errorf("error while listing the versions of asset %s:%s : %s", groupID, assetID, err)
+	}
+
+	versions := append([]ExchangeAsset{response}, response.OtherVersions...)
+	for i := range versions {
+		versions[i].OtherVersions = nil
+	}
+
+	return versions, nil
+}
+
+func (ex *Exchange) SetAssetDescription(groupID, assetID, version, description string) error {
+	resp := new(interface{})
+
+	err := ex.auth.client.PATCH(map[string]string{"description": description}, exchangeAssetPath(EXCHANGE_ASSET, groupID, assetID, version), Application_Json, &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while updating the description of asset %s:%s:%s : %s", groupID, assetID, version, err)
+	}
+
+	return nil
+}
+
+//SetAssetTags replaces the tags of the asset version with the given ones
+func (ex *Exchange) SetAssetTags(groupID, assetID, version string, tags []string) error {
+	resp := new(interface{})
+
+	body := make([]assetTag, 0, len(tags))
+	for _, tag := range tags {
+		body = append(body, assetTag{Value: tag})
+	}
+
+	err := ex.auth.client.PUT(body, exchangeAssetPath(EXCHANGE_ASSET_TAGS, groupID, assetID, version), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while tagging asset %s:%s:%s : %s", groupID, assetID, version, err)
+	}
+
+	return nil
+}
+
+//SetAssetCategory sets the values of a category of the asset version. The category must be defined in
+//the business group. An empty list of values removes the category
+func (ex *Exchange) SetAssetCategory(groupID, assetID, version, key string, values []string) error {
+	resp := new(interface{})
+	path := exchangeAssetPath(EXCHANGE_ASSET_CATEGORY, groupID, assetID, version)
+	path = strings.Replace(path, "{categoryKey}", url.PathEscape(key), -1)
+
+	var err error
+	if len(values) == 0 {
+		err = ex.auth.client.DELETE(nil, path, &resp)
+	} else {
+		err = ex.auth.client.PUT(assetCategoryValues{TagValue: values}, path, &resp)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error while setting category %s of asset %s:%s:%s : %s", key, groupID, assetID, version, err)
+	}
+
+	return nil
+}
+
+//DeprecateAsset flags the asset version as deprecated. It stays available to its current consumers
+func (ex *Exchange) DeprecateAsset(groupID, assetID, version string) error {
+	resp := new(interface{})
+
+	err := ex.auth.client.PATCH(map[string]string{"status": AssetStatusDeprecated}, exchangeAssetPath(EXCHANGE_ASSET_STATUS, groupID, assetID, version), Application_Json, &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deprecating asset %s:%s:%s : %s", groupID, assetID, version, err)
+	}
+
+	return nil
+}
+
+func (ex *Exchange) DeleteAsset(groupID, assetID, version string) error {
+	resp := new(interface{})
+
+	err := ex.auth.client.DELETE(nil, exchangeAssetPath(EXCHANGE_ORG_ASSET, groupID, assetID, version), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting asset %s:%s:%s : %s", groupID, assetID, version, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package sdk
+
+const (
+	AssetClassifierRAML   = "raml"
+	AssetClassifierOAS    = "oas"
+	AssetClassifierHTTP   = "http"
+	AssetClassifierCustom = "custom"
+
+	AssetStatusPublished  = "published"
+	AssetStatusDeprecated = "deprecated"
+)
+
+//Exchange manages the assets and client applications of Anypoint Exchange
+type Exchange struct {
+	auth *AccessManagement
+}
+
+//ClientApplication is a consumer of APIs. Its credentials are generated by the platform
+type ClientApplication struct {
+	ID           int      `json:"id,omitempty"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	URL          string   `json:"url,omitempty"`
+	RedirectURIs []string `json:"redirectUri"`
+	GrantTypes   []string `json:"grantTypes"`
+	ClientID     string   `json:"clientId,omitempty"`
+	ClientSecret string   `json:"clientSecret,omitempty"`
+}
+
+//ExchangeAsset is a version of an asset published to Exchange. FilePath is the local file or archive uploaded
+//with it, if any
+type ExchangeAsset struct {
+	GroupID     string          `json:"groupId"`
+	AssetID     string          `json:"assetId"`
+	Version     string          `json:"version"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Classifier  string          `json:"type,omitempty"`
+	APIVersion  string          `json:"apiVersion,omitempty"`
+	Main        string          `json:"main,omitempty"`
+	Status      string          `json:"status,omitempty"`
+	Labels      []string        `json:"labels,omitempty"`
+	Categories  []AssetCategory `json:"categories,omitempty"`
+	Files       []AssetFile     `json:"files,omitempty"`
+	//OtherVersions lists the other versions of the asset. Only some of their attributes are set
+	OtherVersions []ExchangeAsset `json:"otherVersions,omitempty"`
+	FilePath      string          `json:"-"`
+}
+
+type AssetCategory struct {
+	Key    string   `json:"key"`
+	Values []string `json:"value"`
+}
+
+//AssetFile is a file attached to an asset version, such as its RAML archive or its jar
+type AssetFile struct {
+	Classifier   string `json:"classifier"`
+	Packaging    string `json:"packaging"`
+	MainFile     string `json:"mainFile,omitempty"`
+	DownloadURL  string `json:"downloadURL"`
+	ExternalLink string `json:"externalLink,omitempty"`
+	MD5          string `json:"md5,omitempty"`
+	SHA1         string `json:"sha1,omitempty"`
+}
+
+type assetTag struct {
+	Value string `json:"value"`
+}
+
+type assetCategoryValues struct {
+	TagValue []string `json:"tagValue"`
+}
@@ -0,0 +1,171 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func NewAnypointMQ(auth *AccessManagement, httpWireLog bool) *AnypointMQ {
+	return &AnypointMQ{
+		auth:        auth,
+		httpWireLog: httpWireLog,
+	}
+}
+
+func (mq *AnypointMQ) client(orgID, envID string) *RestClient {
+	return mq.auth.GetARMAuthenticatedHttpClient(orgID, envID, mq.httpWireLog)
+}
+
+//PutQueue creates the queue or updates it when it already exists
+func (mq *AnypointMQ) PutQueue(orgID, envID, regionID string, queue MQQueue) (MQQueue, error) {
+	var response MQQueue
+
+	log.Printf("Saving queue [%s] in %s", queue.QueueID, regionID)
+
+	err := mq.client(orgID, envID).PUT(queue, mqPath(MQ_QUEUE, orgID, envID, regionID, "", queue.QueueID), &response)
+
+	if err != nil {
+		return MQQueue{}, fmt.Errorf("error while saving queue %s : %s", queue.QueueID, err)
+	}
+
+	return response, nil
+}
+
+//GetQueue returns the queue with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (mq *AnypointMQ) GetQueue(orgID, envID, regionID string, queueID string) (MQQueue, error) {
+	var response MQQueue
+
+	err := mq.client(orgID, envID).GET(mqPath(MQ_QUEUE, orgID, envID, regionID, "", queueID), &response)
+
+	if err != nil {
+		return MQQueue{}, err
+	}
+
+	return response, nil
+}
+
+func (mq *AnypointMQ) DeleteQueue(orgID, envID, regionID string, queueID string) error {
+	resp := new(interface{})
+
+	err := mq.client(orgID, envID).DELETE(nil, mqPath(MQ_QUEUE, orgID, envID, regionID, "", queueID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting queue %s : %s", queueID, err)
+	}
+
+	return nil
+}
+
+func (mq *AnypointMQ) PutExchange(orgID, envID, regionID string, exchange MQExchange) (MQExchange, error) {
+	var response MQExchange
+
+	log.Printf("Saving exchange [%s] in %s", exchange.ExchangeID, regionID)
+
+	err := mq.client(orgID, envID).PUT(exchange, mqPath(MQ_EXCHANGE, orgID, envID, regionID, exchange.ExchangeID, ""), &response)
+
+	if err != nil {
+		return MQExchange{}, fmt.Errorf("error while saving exchange %s : %s", exchange.ExchangeID, err)
+	}
+
+	return response, nil
+}
+
+//GetExchange returns the exchange with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (mq *AnypointMQ) GetExchange(orgID, envID, regionID string, exchangeID string) (MQExchange, error) {
+	var response MQExchange
+
+	err := mq.client(orgID, envID).GET(mqPath(MQ_EXCHANGE, orgID, envID, regionID, exchangeID, ""), &response)
+
+	if err != nil {
+		return MQExchange{}, err
+	}
+
+	return response, nil
+}
+
+func (mq *AnypointMQ) DeleteExchange(orgID, envID, regionID string, exchangeID string) error {
+	resp := new(interface{})
+
+	err := mq.client(orgID, envID).DELETE(nil, mqPath(MQ_EXCHANGE, orgID, envID, regionID, exchangeID, ""), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting exchange %s : %s", exchangeID, err)
+	}
+
+	return nil
+}
+
+func (mq *AnypointMQ) BindQueue(orgID, envID, regionID string, exchangeID, queueID string) (MQBinding, error) {
+	var response MQBinding
+
+	log.Printf("Binding queue [%s] to exchange [%s]", queueID, exchangeID)
+
+	err := mq.client(orgID, envID).PUT(MQBinding{ExchangeID: exchangeID, QueueID: queueID}, mqPath(MQ_BINDING, orgID, envID, regionID, exchangeID, queueID), &response)
+
+	if err != nil {
+		return MQBinding{}, fmt.Errorf("error while binding queue %s to exchange %s : %s", queueID, exchangeID, err)
+	}
+
+	return response, nil
+}
+
+//GetBinding returns the binding between the exchange and the queue. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (mq *AnypointMQ) GetBinding(orgID, envID, regionID string, exchangeID, queueID string) (MQBinding, error) {
+	var response MQBinding
+
+	err := mq.client(orgID, envID).GET(mqPath(MQ_BINDING, orgID, envID, regionID, exchangeID, queueID), &response)
+
+	if err != nil {
+		return MQBinding{}, err
+	}
+
+	return response, nil
+}
+
+func (mq *AnypointMQ) UnbindQueue(orgID, envID, regionID string, exchangeID, queueID string) error {
+	resp := new(interface{})
+
+	err := mq.client(orgID, envID).DELETE(nil, mqPath(MQ_BINDING, orgID, envID, regionID, exchangeID, queueID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while unbinding queue %s from exchange %s : %s", queueID, exchangeID, err)
+	}
+
+	return nil
+}
+
+//GetRoutingRules returns the routing rules of the binding. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound, which means the binding has no rules
+func (mq *AnypointMQ) GetRoutingRules(orgID, envID, regionID string, exchangeID, queueID string) ([]MQRoutingRule, error) {
+	var response mqRoutingRules
+
+	err := mq.client(orgID, envID).GET(mqPath(MQ_BINDING_RULES, orgID, envID, regionID, exchangeID, queueID), &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.RoutingRules, nil
+}
+
+//SetRoutingRules replaces the routing rules of the binding. With no rules every message is routed to the queue
+func (mq *AnypointMQ) SetRoutingRules(orgID, envID, regionID string, exchangeID, queueID string, rules []MQRoutingRule) error {
+	resp := new(interface{})
+	path := mqPath(MQ_BINDING_RULES, orgID, envID, regionID, exchangeID, queueID)
+
+	var err error
+	if len(rules) == 0 {
+		err = mq.client(orgID, envID).DELETE(nil, path, &resp)
+	} else {
+		err = mq.client(orgID, envID).PUT(mqRoutingRules{RoutingRules: rules}, path, &resp)
+	}
+
+	if err != nil && !(len(rules) == 0 && IsNotFound(err)) {
+		return fmt.Errorf("error while setting the routing rules of queue %s on exchange %s : %s", queueID, exchangeID, err)
+	}
+
+	return nil
+}
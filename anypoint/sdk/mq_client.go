@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func (mq *AnypointMQ) CreateClient(orgID, envID, name string) (MQClient, error) {
+	var response MQClient
+
+	log.Printf("Creating MQ client [%s]", name)
+
+	err := mq.client(orgID, envID).POST(MQClient{Name: name}, mqClientPath(MQ_CLIENTS, orgID, envID, ""), &response)
+
+	if err != nil {
+		return MQClient{}, fmt.Errorf("error while creating MQ client %s : %s", name, err)
+	}
+
+	return response, nil
+}
+
+//GetClient returns the MQ client with the given ID, secret included. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (mq *AnypointMQ) GetClient(orgID, envID, clientID string) (MQClient, error) {
+	var response MQClient
+
+	err := mq.client(orgID, envID).GET(mqClientPath(MQ_CLIENT, orgID, envID, clientID), &response)
+
+	if err != nil {
+		return MQClient{}, err
+	}
+
+	return response, nil
+}
+
+func (mq *AnypointMQ) RenameClient(orgID, envID, clientID, name string) (MQClient, error) {
+	var response MQClient
+
+	log.Printf("Renaming MQ client %s to [%s]", clientID, name)
+
+	err := mq.client(orgID, envID).PUT(MQClient{Name: name}, mqClientPath(MQ_CLIENT, orgID, envID, clientID), &response)
+
+	if err != nil {
+		return MQClient{}, fmt.Errorf("error while renaming MQ client %s : %s", clientID, err)
+	}
+
+	return response, nil
+}
+
+//RegenerateClientSecret replaces the secret of the MQ client. The previous secret stops working immediately
+func (mq *AnypointMQ) RegenerateClientSecret(orgID, envID, clientID string) (MQClient, error) {
+	var response MQClient
+
+	log.Printf("Regenerating the secret of MQ client %s", clientID)
+
+	err := mq.client(orgID, envID).POST(nil, mqClientPath(MQ_CLIENT_SECRET, orgID, envID, clientID), &response)
+
+	if err != nil {
+		return MQClient{}, fmt.Errorf("error while regenerating the secret of MQ client %s : %s", clientID, err)
+	}
+
+	return response, nil
+}
+
+func (mq *AnypointMQ) DeleteClient(orgID, envID, clientID string) error {
+	resp := new(interface{})
+
+	err := mq.client(orgID, envID).DELETE(nil, mqClientPath(MQ_CLIENT, orgID, envID, clientID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting MQ client %s : %s", clientID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package sdk
+
+const (
+	MQPropertyTypeString  = "STRING"
+	MQPropertyTypeNumeric = "NUMERIC"
+)
+
+//AnypointMQ manages the queues and exchanges of Anypoint MQ. Destinations are scoped by region, organization
+//and environment
+type AnypointMQ struct {
+	auth        *AccessManagement
+	httpWireLog bool
+}
+
+//MQQueue is a queue. TTLs are in milliseconds, the delivery delay in seconds
+type MQQueue struct {
+	QueueID              string `json:"queueId,omitempty"`
+	Type                 string `json:"type,omitempty"`
+	DefaultTTL           int    `json:"defaultTtl"`
+	DefaultLockTTL       int    `json:"defaultLockTtl"`
+	DefaultDeliveryDelay int    `json:"defaultDeliveryDelay"`
+	DeadLetterQueueID    string `json:"deadLetterQueueId,omitempty"`
+	MaxDeliveries        int    `json:"maxDeliveries,omitempty"`
+	Fifo                 bool   `json:"fifo"`
+	Encrypted            bool   `json:"encrypted"`
+}
+
+type MQExchange struct {
+	ExchangeID string `json:"exchangeId,omitempty"`
+	Type       string `json:"type,omitempty"`
+	Encrypted  bool   `json:"encrypted"`
+}
+
+type MQBinding struct {
+	ExchangeID string `json:"exchangeId"`
+	QueueID    string `json:"queueId"`
+}
+
+//MQRoutingRule only routes the messages whose property matches to the bound queue. Value is a string or
+//a number for single value matchers and a list for ANY and NONE
+type MQRoutingRule struct {
+	PropertyName string      `json:"propertyName"`
+	PropertyType string      `json:"propertyType"`
+	MatcherType  string      `json:"matcherType"`
+	Value        interface{} `json:"value"`
+}
+
+//MQClient holds the credentials applications use to connect to the destinations of an environment
+type MQClient struct {
+	ClientID     string `json:"clientId,omitempty"`
+	Name         string `json:"name"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+}
+
+type mqRoutingRules struct {
+	RoutingRules []MQRoutingRule `json:"routingRules"`
+}
@@ -0,0 +1,165 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+)
+
+func NewObjectStore(auth *AccessManagement, httpWireLog bool) *ObjectStore {
+	return &ObjectStore{
+		auth:        auth,
+		httpWireLog: httpWireLog,
+	}
+}
+
+//client returns a client for the Object Store host of the region. The host is derived from the control plane
+//one, so https://anypoint.mulesoft.com becomes https://object-store-us-east-1.anypoint.mulesoft.com
+func (o *ObjectStore) client(orgID, envID, regionID string) (*RestClient, error) {
+	controlPlane, err := url.Parse(o.auth.uri)
+
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing the Anypoint URL %s : %s", o.auth.uri, err)
+	}
+
+	controlPlane.Host = strings.Replace(OBJECT_STORE_HOST, "{regionId}", regionID, 1) + controlPlane.Host
+
+	client := NewRestClient(controlPlane.String(), o.auth.insecure, o.httpWireLog)
+	client.AddAuthHeader(o.auth.Token)
+	client.AddEnvHeader(envID)
+	client.AddOrgHeader(orgID)
+
+	return client, nil
+}
+
+//PutStore creates the store or updates it when it already exists
+func (o *ObjectStore) PutStore(orgID, envID, regionID string, store ObjectStoreStore) (ObjectStoreStore, error) {
+	var response ObjectStoreStore
+
+	log.Printf("Saving store [%s] in %s", store.StoreID, regionID)
+
+	client, err := o.client(orgID, envID, regionID)
+	if err != nil {
+		return ObjectStoreStore{}, err
+	}
+
+	err = client.PUT(store, objectStorePath(OBJECT_STORE_BASE_URI, orgID, envID, store.StoreID, "", ""), &response)
+
+	if err != nil {
+		return ObjectStoreStore{}, fmt.Errorf("error while saving store %s : %s", store.StoreID, err)
+	}
+
+	return response, nil
+}
+
+//GetStore returns the store with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (o *ObjectStore) GetStore(orgID, envID, regionID string, storeID string) (ObjectStoreStore, error) {
+	var response ObjectStoreStore
+
+	client, err := o.client(orgID, envID, regionID)
+	if err != nil {
+		return ObjectStoreStore{}, err
+	}
+
+	err = client.GET(objectStorePath(OBJECT_STORE_BASE_URI, orgID, envID, storeID, "", ""), &response)
+
+	if err != nil {
+		return ObjectStoreStore{}, err
+	}
+
+	return response, nil
+}
+
+//DeleteStore deletes the store along with all of its partitions and keys
+func (o *ObjectStore) DeleteStore(orgID, envID, regionID string, storeID string) error {
+	resp := new(interface{})
+
+	client, err := o.client(orgID, envID, regionID)
+	if err != nil {
+		return err
+	}
+
+	err = client.DELETE(nil, objectStorePath(OBJECT_STORE_BASE_URI, orgID, envID, storeID, "", ""), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting store %s : %s", storeID, err)
+	}
+
+	return nil
+}
+
+//GetPartitions returns the names of the partitions holding at least one key
+func (o *ObjectStore) GetPartitions(orgID, envID, regionID string, storeID string) ([]string, error) {
+	var response objectStorePartitions
+
+	client, err := o.client(orgID, envID, regionID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.GET(objectStorePath(OBJECT_STORE_PARTITIONS, orgID, envID, storeID, "", ""), &response)
+
+	if err != nil {
+		return nil, fmt.Errorf("error while reading the partitions of store %s : %s", storeID, err)
+	}
+
+	return response.Values, nil
+}
+
+//PutKey writes the key, creating the partition when needed
+func (o *ObjectStore) PutKey(orgID, envID, regionID string, storeID, partitionID string, key ObjectStoreKey) (ObjectStoreKey, error) {
+	var response ObjectStoreKey
+
+	log.Printf("Saving key [%s] in partition %s of store %s", key.KeyID, partitionID, storeID)
+
+	client, err := o.client(orgID, envID, regionID)
+	if err != nil {
+		return ObjectStoreKey{}, err
+	}
+
+	err = client.PUT(key, objectStorePath(OBJECT_STORE_KEY, orgID, envID, storeID, partitionID, key.KeyID), &response)
+
+	if err != nil {
+		return ObjectStoreKey{}, fmt.Errorf("error while saving key %s : %s", key.KeyID, err)
+	}
+
+	return response, nil
+}
+
+//GetKey returns the key with its value. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (o *ObjectStore) GetKey(orgID, envID, regionID string, storeID, partitionID, keyID string) (ObjectStoreKey, error) {
+	var response ObjectStoreKey
+
+	client, err := o.client(orgID, envID, regionID)
+	if err != nil {
+		return ObjectStoreKey{}, err
+	}
+
+	err = client.GET(objectStorePath(OBJECT_STORE_KEY, orgID, envID, storeID, partitionID, keyID), &response)
+
+	if err != nil {
+		return ObjectStoreKey{}, err
+	}
+
+	return response, nil
+}
+
+func (o *ObjectStore) DeleteKey(orgID, envID, regionID string, storeID, partitionID, keyID string) error {
+	resp := new(interface{})
+
+	client, err := o.client(orgID, envID, regionID)
+	if err != nil {
+		return err
+	}
+
+	err = client.DELETE(nil, objectStorePath(OBJECT_STORE_KEY, orgID, envID, storeID, partitionID, keyID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting key %s : %s", keyID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package sdk
+
+const (
+	ObjectStoreValueString = "STRING"
+	ObjectStoreValueBinary = "BINARY"
+)
+
+//ObjectStore manages Object Store v2 stores and keys. The API is served by a host per region, so unlike the
+//other services it does not go through the control plane host
+type ObjectStore struct {
+	auth        *AccessManagement
+	httpWireLog bool
+}
+
+//ObjectStoreStore is a store. DefaultTTLSeconds applies to keys written without their own TTL
+type ObjectStoreStore struct {
+	StoreID           string `json:"storeId"`
+	DefaultTTLSeconds int    `json:"defaultTtlSeconds"`
+	Persistent        bool   `json:"persistent"`
+}
+
+//ObjectStoreKey is a key and its value. BinaryValue is base64 encoded
+type ObjectStoreKey struct {
+	KeyID       string `json:"keyId"`
+	ValueType   string `json:"valueType"`
+	StringValue string `json:"stringValue,omitempty"`
+	BinaryValue string `json:"binaryValue,omitempty"`
+	TTLSeconds  int    `json:"ttlSeconds,omitempty"`
+}
+
+type objectStorePartitions struct {
+	Values []string `json:"values"`
+}
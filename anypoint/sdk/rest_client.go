@@ -17,6 +17,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"gopkg.in/resty.v1"
+	"io"
 	"log"
 	"net/http"
 )
@@ -28,8 +29,6 @@ type HttpError struct {
 
 type ContentType int
 
-var headers map[string]string = make(map[string]string, 0)
-
 const (
 	Application_Json ContentType = iota
 	Application_OctetStream
@@ -46,6 +45,42 @@ const (
 	Wildcard
 )
 
+var contentTypes = map[ContentType]string{
+	Application_Json:            "application/json",
+	Application_OctetStream:     "application/octet-stream",
+	Application_Pdf:             "application/pdf",
+	Application_Atom_Xml:        "application/atom+xml",
+	Application_Form_Urlencoded: "application/x-www-form-urlencoded",
+	Application_SVG_XML:         "image/svg+xml",
+	Application_XHTML_XML:       "application/xhtml+xml",
+	Application_XML:             "application/xml",
+	Multipart_Form_Data:         "multipart/form-data",
+	Text_HTML:                   "text/html",
+	Text_Plain:                  "text/plain",
+	Text_XML:                    "text/xml",
+	Wildcard:                    "*/*",
+}
+
+//String returns the MIME type of the content type
+func (c ContentType) String() string {
+	return contentTypes[c]
+}
+
+//MultipartFile is a file part of a multipart/form-data request. ContentType defaults to Application_Json
+//(the zero value), so binary parts should set Application_OctetStream explicitly
+type MultipartFile struct {
+	Param       string
+	FileName    string
+	ContentType ContentType
+	Reader      io.Reader
+}
+
+//MultipartBody is the body of a multipart/form-data request: plain form fields plus file parts
+type MultipartBody struct {
+	Fields map[string]string
+	Files  []MultipartFile
+}
+
 func (e *HttpError) Error() string {
 	return fmt.Sprintf("HTTP Error %d - %s", e.StatusCode, e.msg)
 }
@@ -57,6 +92,12 @@ func NewHttpError(code int, theMsg string) error {
 	}
 }
 
+//IsNotFound returns true when err is an HTTP 404 returned by the RestClient
+func IsNotFound(err error) bool {
+	httpErr, ok := err.(*HttpError)
+	return ok && httpErr.StatusCode == 404
+}
+
 type RestClient struct {
 	URI   string
 	resty *resty.Client
@@ -84,31 +125,20 @@ func NewRestClient(uri string, insecure, debugMode bool) *RestClient {
 }
 
 func (restClient *RestClient) AddAuthHeader(token string) *RestClient {
-	/*if headers["Authorization"] == "" {
-		//restClient.resty.SetAuthToken(token)
-		restClient.resty.SetHeader("Authorization", "bearer "+token)
-		restClient.resty.SetHeader("x-anypoint-session-extend", "true")
-
-		headers["Authorization"] = token
-	}
-	*/
 	restClient.resty.SetAuthToken(token)
 	return restClient
 }
 
+//AddOrgHeader sets the organization header on this client only. Every ARM client is scoped to
+//its own org, so the header must not be shared across clients
 func (restClient *RestClient) AddOrgHeader(orgId string) *RestClient {
-	if headers["X-ANYPNT-ORG-ID"] == "" {
-		restClient.resty.SetHeader("X-ANYPNT-ORG-ID", orgId)
-		headers["X-ANYPNT-ORG-ID"] = orgId
-	}
+	restClient.resty.SetHeader("X-ANYPNT-ORG-ID", orgId)
 	return restClient
 }
 
+//AddEnvHeader sets the environment header on this client only
 func (restClient *RestClient) AddEnvHeader(envId string) *RestClient {
-	if headers["X-ANYPNT-ENV-ID"] == "" {
-		restClient.resty.SetHeader("X-ANYPNT-ENV-ID", envId)
-		headers["X-ANYPNT-ENV-ID"] = envId
-	}
+	restClient.resty.SetHeader("X-ANYPNT-ENV-ID", envId)
 	return restClient
 }
 
@@ -159,13 +189,87 @@ func (restClient *RestClient) GET(path string, responseObj interface{}) error {
 //PATCH - Perform an HTTP PATCH
 func (restClient *RestClient) PATCH(body interface{}, path string, cType ContentType, responseObj interface{}) error {
 
-	res, err := restClient.resty.R().SetBody(body).SetResult(responseObj).Patch(path)
+	req, err := restClient.newRequest(body, cType, responseObj)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := req.Patch(path)
 
 	httpErr := validateResponse(res.RawResponse, err, "POST", path)
 
 	return httpErr
 }
 
+//POSTWithContentType - Perform an HTTP POST sending the body with the given content type.
+//When cType is Multipart_Form_Data the body must be a MultipartBody
+func (restClient *RestClient) POSTWithContentType(body interface{}, path string, cType ContentType, responseObj interface{}) error {
+	log.Printf("POST-ing %s to %s", cType, restClient.URI+path)
+
+	req, err := restClient.newRequest(body, cType, responseObj)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := req.Post(path)
+
+	if err != nil {
+		log.Printf("Error while executing POST %s : %s", path, err)
+		return err
+	}
+
+	return validateResponse(res.RawResponse, err, "POST", path)
+}
+
+//PUTWithContentType - Perform an HTTP PUT sending the body with the given content type.
+//When cType is Multipart_Form_Data the body must be a MultipartBody
+func (restClient *RestClient) PUTWithContentType(body interface{}, path string, cType ContentType, responseObj interface{}) error {
+	log.Printf("PUT-ing %s to %s", cType, restClient.URI+path)
+
+	req, err := restClient.newRequest(body, cType, responseObj)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := req.Put(path)
+
+	if err != nil {
+		log.Printf("Error while executing PUT %s : %s", path, err)
+		return err
+	}
+
+	return validateResponse(res.RawResponse, err, "PUT", path)
+}
+
+func (restClient *RestClient) newRequest(body interface{}, cType ContentType, responseObj interface{}) (*resty.Request, error) {
+	req := restClient.resty.R().SetResult(responseObj)
+
+	if cType != Multipart_Form_Data {
+		if cType != Application_Json {
+			req.SetHeader("Content-Type", cType.String())
+		}
+		return req.SetBody(body), nil
+	}
+
+	multipart, ok := body.(MultipartBody)
+	if !ok {
+		return nil, fmt.Errorf("a %s request requires a MultipartBody, got %T", cType, body)
+	}
+
+	if multipart.Fields != nil {
+		req.SetFormData(multipart.Fields)
+	}
+
+	for _, file := range multipart.Files {
+		req.SetMultipartField(file.Param, file.FileName, file.ContentType.String(), file.Reader)
+	}
+
+	return req, nil
+}
+
 //POST - Perform an HTTP POST
 func (restClient *RestClient) POST(body interface{}, path string, responseObj interface{}) error {
 	log.Printf("POST-ing to %s", restClient.URI+path)
@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func NewRuntimeFabric(auth *AccessManagement) *RuntimeFabric {
+	return &RuntimeFabric{
+		auth: auth,
+	}
+}
+
+func (rtf *RuntimeFabric) CreatePrivateSpace(orgID, name, region string) (PrivateSpace, error) {
+	var response PrivateSpace
+
+	body := PrivateSpace{
+		Name:   name,
+		Region: region,
+	}
+
+	log.Printf("Creating private space [%s] in %s", name, region)
+
+	err := rtf.auth.client.POST(body, privateSpacesPath(orgID), &response)
+
+	if err != nil {
+		return PrivateSpace{}, fmt.Errorf("error while creating private space %s : %s", name, err)
+	}
+
+	return response, nil
+}
+
+//GetPrivateSpace returns the private space with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (rtf *RuntimeFabric) GetPrivateSpace(orgID, spaceID string) (PrivateSpace, error) {
+	var response PrivateSpace
+
+	err := rtf.auth.client.GET(privateSpacePath(orgID, spaceID), &response)
+
+	if err != nil {
+		return PrivateSpace{}, err
+	}
+
+	return response, nil
+}
+
+func (rtf *RuntimeFabric) RenamePrivateSpace(orgID, spaceID, name string) (PrivateSpace, error) {
+	return rtf.patchPrivateSpace(orgID, spaceID, PrivateSpace{Name: name})
+}
+
+//ConfigurePrivateSpaceNetwork sets the CIDR block and the reserved CIDRs of the private space network
+func (rtf *RuntimeFabric) ConfigurePrivateSpaceNetwork(orgID, spaceID string, network PrivateSpaceNetwork) (PrivateSpace, error) {
+	return rtf.patchPrivateSpace(orgID, spaceID, PrivateSpace{Network: &network})
+}
+
+//SetPrivateSpaceFirewallRules replaces every firewall rule of the private space with the given ones
+func (rtf *RuntimeFabric) SetPrivateSpaceFirewallRules(orgID, spaceID string, rules []FirewallRule) (PrivateSpace, error) {
+	if rules == nil {
+		rules = []FirewallRule{}
+	}
+
+	return rtf.patchPrivateSpace(orgID, spaceID, privateSpaceFirewallRules{FirewallRules: rules})
+}
+
+func (rtf *RuntimeFabric) DeletePrivateSpace(orgID, spaceID string) error {
+	resp := new(interface{})
+
+	err := rtf.auth.client.DELETE(nil, privateSpacePath(orgID, spaceID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting private space with ID %s : %s", spaceID, err)
+	}
+
+	return nil
+}
+
+func (rtf *RuntimeFabric) patchPrivateSpace(orgID, spaceID string, body interface{}) (PrivateSpace, error) {
+	var response PrivateSpace
+
+	log.Printf("Updating private space %s", spaceID)
+
+	err := rtf.auth.client.PATCH(body, privateSpacePath(orgID, spaceID), Application_Json, &response)
+
+	if err != nil {
+		return PrivateSpace{}, fmt.Errorf("error while updating private space %s : %s", spaceID, err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func (rtf *RuntimeFabric) CreateFabric(orgID string, fabric Fabric) (Fabric, error) {
+	var response Fabric
+
+	log.Printf("Creating fabric [%s] in %s", fabric.Name, fabric.Region)
+
+	err := rtf.auth.client.POST(fabric, fabricPath(FABRICS, orgID, ""), &response)
+
+	if err != nil {
+		return Fabric{}, fmt.Errorf("error while creating fabric %s : %s", fabric.Name, err)
+	}
+
+	return response, nil
+}
+
+//GetFabric returns the fabric with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (rtf *RuntimeFabric) GetFabric(orgID, fabricID string) (Fabric, error) {
+	var response Fabric
+
+	err := rtf.auth.client.GET(fabricPath(FABRIC, orgID, fabricID), &response)
+
+	if err != nil {
+		return Fabric{}, err
+	}
+
+	return response, nil
+}
+
+func (rtf *RuntimeFabric) RenameFabric(orgID, fabricID, name string) (Fabric, error) {
+	return rtf.patchFabric(orgID, fabricID, Fabric{Name: name})
+}
+
+//SetFabricIngress replaces the ingress template of the fabric
+func (rtf *RuntimeFabric) SetFabricIngress(orgID, fabricID string, ingress FabricIngress) (Fabric, error) {
+	if ingress.Domains == nil {
+		ingress.Domains = []string{}
+	}
+
+	return rtf.patchFabric(orgID, fabricID, Fabric{Ingress: &ingress})
+}
+
+func (rtf *RuntimeFabric) DeleteFabric(orgID, fabricID string) error {
+	resp := new(interface{})
+
+	err := rtf.auth.client.DELETE(nil, fabricPath(FABRIC, orgID, fabricID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting fabric with ID %s : %s", fabricID, err)
+	}
+
+	return nil
+}
+
+//GetFabricAssociations returns the business groups and environments the fabric is available to.
+//Errors are returned as they come from the RestClient so that callers can check IsNotFound
+func (rtf *RuntimeFabric) GetFabricAssociations(orgID, fabricID string) ([]FabricAssociation, error) {
+	var response []FabricAssociation
+
+	err := rtf.auth.client.GET(fabricPath(FABRIC_ASSOCIATIONS, orgID, fabricID), &response)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+//SetFabricAssociations replaces every association of the fabric with the given ones
+func (rtf *RuntimeFabric) SetFabricAssociations(orgID, fabricID string, associations []FabricAssociation) error {
+	resp := new(interface{})
+
+	if associations == nil {
+		associations = []FabricAssociation{}
+	}
+
+	log.Printf("Associating fabric %s with %d environments", fabricID, len(associations))
+
+	err := rtf.auth.client.POST(fabricAssociations{Associations: associations}, fabricPath(FABRIC_ASSOCIATIONS, orgID, fabricID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while associating fabric %s : %s", fabricID, err)
+	}
+
+	return nil
+}
+
+func (rtf *RuntimeFabric) patchFabric(orgID, fabricID string, body interface{}) (Fabric, error) {
+	var response Fabric
+
+	log.Printf("Updating fabric %s", fabricID)
+
+	err := rtf.auth.client.PATCH(body, fabricPath(FABRIC, orgID, fabricID), Application_Json, &response)
+
+	if err != nil {
+		return Fabric{}, fmt.Errorf("error while updating fabric %s : %s", fabricID, err)
+	}
+
+	return response, nil
+}
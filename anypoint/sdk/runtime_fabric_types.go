@@ -0,0 +1,79 @@
+package sdk
+
+const (
+	PrivateSpaceStatusActive   = "Active"
+	PrivateSpaceStatusCreating = "Creating"
+	PrivateSpaceStatusUpdating = "Updating"
+	PrivateSpaceStatusFailed   = "Failed"
+
+	//FabricAllEnvironments associates a fabric with every environment of a business group
+	FabricAllEnvironments = "all"
+)
+
+//RuntimeFabric manages the resources of the Runtime Fabric API: CloudHub 2.0 private spaces and fabrics
+type RuntimeFabric struct {
+	auth *AccessManagement
+}
+
+type PrivateSpace struct {
+	ID            string               `json:"id,omitempty"`
+	Name          string               `json:"name,omitempty"`
+	Region        string               `json:"region,omitempty"`
+	Status        string               `json:"status,omitempty"`
+	Network       *PrivateSpaceNetwork `json:"network,omitempty"`
+	FirewallRules []FirewallRule       `json:"firewallRules,omitempty"`
+}
+
+type PrivateSpaceNetwork struct {
+	Region            string   `json:"region,omitempty"`
+	CidrBlock         string   `json:"cidrBlock,omitempty"`
+	ReservedCidrs     []string `json:"reservedCidrs,omitempty"`
+	InboundStaticIPs  []string `json:"inboundStaticIps,omitempty"`
+	OutboundStaticIPs []string `json:"outboundStaticIps,omitempty"`
+	DNSTarget         string   `json:"dnsTarget,omitempty"`
+}
+
+//FirewallRule allows inbound or outbound traffic for a CIDR on a port range
+type FirewallRule struct {
+	Type      string `json:"type"`
+	CidrBlock string `json:"cidrBlock"`
+	Protocol  string `json:"protocol"`
+	FromPort  int    `json:"fromPort"`
+	ToPort    int    `json:"toPort"`
+}
+
+//privateSpaceFirewallRules is sent as is so that an empty list clears every rule
+type privateSpaceFirewallRules struct {
+	FirewallRules []FirewallRule `json:"firewallRules"`
+}
+
+//Fabric is a Runtime Fabric cluster. ActivationData is passed to the installer of the cluster
+//to register it with the control plane
+type Fabric struct {
+	ID             string         `json:"id,omitempty"`
+	Name           string         `json:"name,omitempty"`
+	Vendor         string         `json:"vendor,omitempty"`
+	Region         string         `json:"region,omitempty"`
+	Status         string         `json:"status,omitempty"`
+	Version        string         `json:"version,omitempty"`
+	ActivationData string         `json:"activationData,omitempty"`
+	Ingress        *FabricIngress `json:"ingress,omitempty"`
+}
+
+//FabricIngress configures the ingress template applied to the applications deployed to the fabric.
+//Domains may use wildcards such as *.apps.example.com
+type FabricIngress struct {
+	Domains []string `json:"domains"`
+}
+
+//FabricAssociation makes a fabric available to the environment of a business group. EnvironmentID is
+//FabricAllEnvironments to target every environment
+type FabricAssociation struct {
+	ID             string `json:"id,omitempty"`
+	OrganizationID string `json:"organizationId"`
+	EnvironmentID  string `json:"environment"`
+}
+
+type fabricAssociations struct {
+	Associations []FabricAssociation `json:"associations"`
+}
@@ -0,0 +1,160 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+func NewRuntimeManager(auth *AccessManagement, httpWireLog bool) *RuntimeManager {
+	return &RuntimeManager{
+		auth:        auth,
+		httpWireLog: httpWireLog,
+	}
+}
+
+func (rm *RuntimeManager) client(orgID, envID string) *RestClient {
+	return rm.auth.GetARMAuthenticatedHttpClient(orgID, envID, rm.httpWireLog)
+}
+
+//DeployHybridApplication deploys a new application to a hybrid server, server group or cluster
+func (rm *RuntimeManager) DeployHybridApplication(orgID, envID string, deployment HybridDeployment) (HybridApplication, error) {
+	var response hybridApplicationResponse
+
+	log.Printf("Deploying hybrid application [%s] to target %d", deployment.ArtifactName, deployment.TargetID)
+
+	err := rm.sendHybridDeployment(orgID, envID, HYBRID_APPLICATIONS, false, deployment, &response)
+
+	if err != nil {
+		return HybridApplication{}, fmt.Errorf("error while deploying hybrid application %s : %s", deployment.ArtifactName, err)
+	}
+
+	return response.Data, nil
+}
+
+//RedeployHybridApplication replaces the artifact and/or properties of an existing hybrid application
+func (rm *RuntimeManager) RedeployHybridApplication(orgID, envID string, appID int, deployment HybridDeployment) (HybridApplication, error) {
+	var response hybridApplicationResponse
+
+	log.Printf("Redeploying hybrid application [%s] (%d)", deployment.ArtifactName, appID)
+
+	err := rm.sendHybridDeployment(orgID, envID, hybridApplicationPath(appID), true, deployment, &response)
+
+	if err != nil {
+		return HybridApplication{}, fmt.Errorf("error while redeploying hybrid application %s : %s", deployment.ArtifactName, err)
+	}
+
+	return response.Data, nil
+}
+
+//ListHybridApplications returns the applications deployed to the hybrid servers, server groups and clusters
+//of an environment
+func (rm *RuntimeManager) ListHybridApplications(orgID, envID string) ([]HybridApplication, error) {
+	var response hybridApplicationsResponse
+
+	err := rm.client(orgID, envID).GET(HYBRID_APPLICATIONS, &response)
+
+	if err != nil {
+		return nil, fmt.Errorf("error while listing hybrid applications of environment %s : %s", envID, err)
+	}
+
+	return response.Data, nil
+}
+
+//GetHybridApplication returns the application with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (rm *RuntimeManager) GetHybridApplication(orgID, envID string, appID int) (HybridApplication, error) {
+	var response hybridApplicationResponse
+
+	err := rm.client(orgID, envID).GET(hybridApplicationPath(appID), &response)
+
+	if err != nil {
+		return HybridApplication{}, err
+	}
+
+	return response.Data, nil
+}
+
+func (rm *RuntimeManager) DeleteHybridApplication(orgID, envID string, appID int) error {
+	resp := new(interface{})
+
+	err := rm.client(orgID, envID).DELETE(nil, hybridApplicationPath(appID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting hybrid application with ID %d : %s", appID, err)
+	}
+
+	return nil
+}
+
+func (rm *RuntimeManager) sendHybridDeployment(orgID, envID, path string, redeploy bool, deployment HybridDeployment, response interface{}) error {
+	client := rm.client(orgID, envID)
+
+	var configuration map[string]interface{}
+	if deployment.Properties != nil {
+		configuration = map[string]interface{}{
+			propertiesServiceKey: map[string]interface{}{
+				"applicationName": deployment.ArtifactName,
+				"properties":      deployment.Properties,
+			},
+		}
+	}
+
+	if deployment.Source != nil {
+		body := hybridExchangeDeployment{
+			ApplicationSource: *deployment.Source,
+			ArtifactName:      deployment.ArtifactName,
+			TargetID:          deployment.TargetID,
+			Configuration:     configuration,
+		}
+
+		if redeploy {
+			return client.PATCH(body, path, Application_Json, response)
+		}
+		return client.POST(body, path, response)
+	}
+
+	if deployment.ArtifactPath == "" {
+		return errors.New("either an artifact path or an Exchange source is required")
+	}
+
+	artifact, err := os.Open(deployment.ArtifactPath)
+	if err != nil {
+		return fmt.Errorf("unable to open artifact %s : %s", deployment.ArtifactPath, err)
+	}
+	defer artifact.Close()
+
+	body := MultipartBody{
+		Fields: map[string]string{},
+		Files: []MultipartFile{
+			{
+				Param:       "file",
+				FileName:    filepath.Base(deployment.ArtifactPath),
+				ContentType: Application_OctetStream,
+				Reader:      artifact,
+			},
+		},
+	}
+
+	if !redeploy {
+		body.Fields["artifactName"] = deployment.ArtifactName
+		body.Fields["targetId"] = strconv.Itoa(deployment.TargetID)
+	}
+
+	if configuration != nil {
+		conf, err := json.Marshal(configuration)
+		if err != nil {
+			return fmt.Errorf("unable to encode properties of %s : %s", deployment.ArtifactName, err)
+		}
+		body.Fields["configuration"] = string(conf)
+	}
+
+	if redeploy {
+		return client.PATCH(body, path, Multipart_Form_Data, response)
+	}
+	return client.POSTWithContentType(body, path, Multipart_Form_Data, response)
+}
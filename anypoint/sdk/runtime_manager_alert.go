@@ -0,0 +1,60 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func (rm *RuntimeManager) CreateRuntimeAlert(orgID, envID string, alert RuntimeAlert) (RuntimeAlert, error) {
+	var response RuntimeAlert
+
+	log.Printf("Creating runtime alert [%s]", alert.Name)
+
+	err := rm.client(orgID, envID).POST(alert, RUNTIME_ALERTS, &response)
+
+	if err != nil {
+		return RuntimeAlert{}, fmt.Errorf("error while creating runtime alert %s : %s", alert.Name, err)
+	}
+
+	return response, nil
+}
+
+//GetRuntimeAlert returns the alert with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (rm *RuntimeManager) GetRuntimeAlert(orgID, envID, alertID string) (RuntimeAlert, error) {
+	var response RuntimeAlert
+
+	err := rm.client(orgID, envID).GET(runtimeAlertPath(alertID), &response)
+
+	if err != nil {
+		return RuntimeAlert{}, err
+	}
+
+	return response, nil
+}
+
+func (rm *RuntimeManager) UpdateRuntimeAlert(orgID, envID, alertID string, alert RuntimeAlert) (RuntimeAlert, error) {
+	var response RuntimeAlert
+
+	log.Printf("Updating runtime alert %s", alertID)
+
+	err := rm.client(orgID, envID).PUT(alert, runtimeAlertPath(alertID), &response)
+
+	if err != nil {
+		return RuntimeAlert{}, fmt.Errorf("error while updating runtime alert %s : %s", alertID, err)
+	}
+
+	return response, nil
+}
+
+func (rm *RuntimeManager) DeleteRuntimeAlert(orgID, envID, alertID string) error {
+	resp := new(interface{})
+
+	err := rm.client(orgID, envID).DELETE(nil, runtimeAlertPath(alertID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting runtime alert %s : %s", alertID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func (rm *RuntimeManager) CreateFlexGateway(orgID, envID, name string) (FlexGateway, error) {
+	var response FlexGateway
+
+	log.Printf("Creating Flex Gateway [%s]", name)
+
+	err := rm.client(orgID, envID).POST(FlexGateway{Name: name}, flexGatewayPath(FLEX_GATEWAYS, orgID, envID, ""), &response)
+
+	if err != nil {
+		return FlexGateway{}, fmt.Errorf("error while creating Flex Gateway %s : %s", name, err)
+	}
+
+	return response, nil
+}
+
+//GetFlexGateway returns the gateway with the given ID along with its replicas. Errors are returned as they
+//come from the RestClient so that callers can check IsNotFound
+func (rm *RuntimeManager) GetFlexGateway(orgID, envID, gatewayID string) (FlexGateway, error) {
+	var response FlexGateway
+
+	err := rm.client(orgID, envID).GET(flexGatewayPath(FLEX_GATEWAY, orgID, envID, gatewayID), &response)
+
+	if err != nil {
+		return FlexGateway{}, err
+	}
+
+	return response, nil
+}
+
+func (rm *RuntimeManager) RenameFlexGateway(orgID, envID, gatewayID, name string) (FlexGateway, error) {
+	var response FlexGateway
+
+	err := rm.client(orgID, envID).PATCH(FlexGateway{Name: name}, flexGatewayPath(FLEX_GATEWAY, orgID, envID, gatewayID), Application_Json, &response)
+
+	if err != nil {
+		return FlexGateway{}, fmt.Errorf("error while renaming Flex Gateway %s : %s", gatewayID, err)
+	}
+
+	return response, nil
+}
+
+//GetFlexGatewayRegistration returns the token and the configuration file used to register replicas
+func (rm *RuntimeManager) GetFlexGatewayRegistration(orgID, envID, gatewayID string) (FlexGatewayRegistration, error) {
+	var response FlexGatewayRegistration
+
+	err := rm.client(orgID, envID).GET(flexGatewayPath(FLEX_GATEWAY_REGISTRATION, orgID, envID, gatewayID), &response)
+
+	if err != nil {
+		return FlexGatewayRegistration{}, fmt.Errorf("error while reading the registration of Flex Gateway %s : %s", gatewayID, err)
+	}
+
+	return response, nil
+}
+
+func (rm *RuntimeManager) DeleteFlexGateway(orgID, envID, gatewayID string) error {
+	resp := new(interface{})
+
+	err := rm.client(orgID, envID).DELETE(nil, flexGatewayPath(FLEX_GATEWAY, orgID, envID, gatewayID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting Flex Gateway %s : %s", gatewayID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package sdk
+
+const (
+	HybridStatusStarted          = "STARTED"
+	HybridStatusDeploymentFailed = "DEPLOYMENT_FAILED"
+	HybridStatusFailed           = "FAILED"
+
+	ApplicationSourceExchange = "EXCHANGE"
+
+	propertiesServiceKey = "mule.agent.application.properties.service"
+)
+
+type RuntimeManager struct {
+	auth        *AccessManagement
+	httpWireLog bool
+}
+
+//HybridDeployment describes what to deploy to a hybrid target. Exactly one of ArtifactPath
+//(a local jar uploaded as multipart) or Source (an Exchange coordinate) must be set. Properties are
+//left untouched when nil and cleared when empty
+type HybridDeployment struct {
+	ArtifactName string
+	TargetID     int
+	ArtifactPath string
+	Source       *ApplicationSource
+	Properties   map[string]string
+}
+
+type ApplicationSource struct {
+	Source         string `json:"source"`
+	GroupID        string `json:"groupId"`
+	ArtifactID     string `json:"artifactId"`
+	Version        string `json:"version"`
+	OrganizationID string `json:"organizationId,omitempty"`
+}
+
+type hybridExchangeDeployment struct {
+	ApplicationSource ApplicationSource      `json:"applicationSource"`
+	ArtifactName      string                 `json:"artifactName"`
+	TargetID          int                    `json:"targetId"`
+	Configuration     map[string]interface{} `json:"configuration,omitempty"`
+}
+
+type hybridApplicationResponse struct {
+	Data HybridApplication `json:"data"`
+}
+
+type hybridApplicationsResponse struct {
+	Data []HybridApplication `json:"data"`
+}
+
+type HybridApplication struct {
+	ID                 int              `json:"id"`
+	DesiredStatus      string           `json:"desiredStatus"`
+	LastReportedStatus string           `json:"lastReportedStatus"`
+	Artifact           HybridArtifact   `json:"artifact"`
+	Target             HybridTarget     `json:"target"`
+	ServerArtifacts    []ServerArtifact `json:"serverArtifacts"`
+}
+
+type HybridArtifact struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	FileName     string `json:"fileName"`
+	FileChecksum string `json:"fileChecksum"`
+}
+
+//HybridTarget is the server, server group or cluster an application is deployed to
+type HybridTarget struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+//ServerArtifact is the deployment of an application on a single server of the target
+type ServerArtifact struct {
+	ID                 int    `json:"id"`
+	ServerID           int    `json:"serverId"`
+	DesiredStatus      string `json:"desiredStatus"`
+	LastReportedStatus string `json:"lastReportedStatus"`
+	Message            string `json:"message"`
+}
+
+//FlexGateway is a gateway target of an environment. Replicas register against it with the token
+//or the configuration file of its FlexGatewayRegistration
+type FlexGateway struct {
+	ID       string               `json:"id,omitempty"`
+	Name     string               `json:"name"`
+	Status   string               `json:"status,omitempty"`
+	Replicas []FlexGatewayReplica `json:"replicas,omitempty"`
+}
+
+//FlexGatewayReplica is a running instance of a gateway. LastSeen is an RFC 3339 timestamp
+type FlexGatewayReplica struct {
+	ID       string `json:"id"`
+	Hostname string `json:"hostname"`
+	Status   string `json:"status"`
+	Version  string `json:"version"`
+	LastSeen string `json:"lastSeen"`
+}
+
+//FlexGatewayRegistration holds what flexctl needs to register a replica: the token for
+//`flexctl registration create` or the contents of the registration.yaml file
+type FlexGatewayRegistration struct {
+	Token         string `json:"token"`
+	Configuration string `json:"configuration"`
+}
+
+const (
+	AlertSeverityCritical = "CRITICAL"
+	AlertSeverityWarning  = "WARNING"
+	AlertSeverityInfo     = "INFO"
+
+	AlertResourceCloudHubApplication = "cloudhub-application"
+	AlertResourceHybridServer        = "server"
+	AlertResourceHybridApplication   = "application"
+	AlertResourceHybridCluster       = "cluster"
+
+	AlertConditionCPU              = "cpu"
+	AlertConditionMemory           = "memory"
+	AlertConditionEventCount       = "event-count"
+	AlertConditionDeploymentFailed = "deployment-failed"
+	AlertConditionCustomEvent      = "custom-event"
+)
+
+//RuntimeAlert notifies the recipients when the condition is met by one of the resources. Resources
+//are application names for CloudHub and IDs for hybrid servers, applications and clusters
+type RuntimeAlert struct {
+	ID           string                `json:"id,omitempty"`
+	Name         string                `json:"name"`
+	Severity     string                `json:"severity"`
+	ResourceType string                `json:"resourceType"`
+	Resources    []string              `json:"resources"`
+	Condition    RuntimeAlertCondition `json:"condition"`
+	Recipients   []string              `json:"recipients"`
+	Enabled      bool                  `json:"enabled"`
+}
+
+//RuntimeAlertCondition is met when the metric stays beyond the threshold for Period minutes.
+//Deployment failures and custom events trigger the alert as soon as they happen
+type RuntimeAlertCondition struct {
+	Type            string  `json:"type"`
+	Operator        string  `json:"operator,omitempty"`
+	Threshold       float64 `json:"threshold,omitempty"`
+	Period          int     `json:"period,omitempty"`
+	CustomEventName string  `json:"customEventName,omitempty"`
+}
@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+)
+
+func NewSecretsManager(auth *AccessManagement) *SecretsManager {
+	return &SecretsManager{
+		auth: auth,
+	}
+}
+
+func (sm *SecretsManager) CreateSecretGroup(orgID, envID string, group SecretGroup) (string, error) {
+	var response secretCreated
+
+	log.Printf("Creating secret group [%s]", group.Name)
+
+	err := sm.auth.client.POST(group, secretsPath(SECRET_GROUPS, orgID, envID, "", "", ""), &response)
+
+	if err != nil {
+		return "", fmt.Errorf("error while creating secret group %s : %s", group.Name, err)
+	}
+
+	return response.ID, nil
+}
+
+//GetSecretGroup returns the secret group with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (sm *SecretsManager) GetSecretGroup(orgID, envID, groupID string) (SecretGroup, error) {
+	var response SecretGroup
+
+	err := sm.auth.client.GET(secretsPath(SECRET_GROUP, orgID, envID, groupID, "", ""), &response)
+
+	if err != nil {
+		return SecretGroup{}, err
+	}
+
+	return response, nil
+}
+
+func (sm *SecretsManager) UpdateSecretGroup(orgID, envID, groupID string, group SecretGroup) error {
+	resp := new(interface{})
+
+	err := sm.auth.client.PUT(group, secretsPath(SECRET_GROUP, orgID, envID, groupID, "", ""), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while updating secret group %s : %s", groupID, err)
+	}
+
+	return nil
+}
+
+//DeleteSecretGroup deletes the group. The API refuses to delete groups which still hold secrets
+func (sm *SecretsManager) DeleteSecretGroup(orgID, envID, groupID string) error {
+	resp := new(interface{})
+
+	err := sm.auth.client.DELETE(nil, secretsPath(SECRET_GROUP, orgID, envID, groupID, "", ""), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting secret group %s : %s", groupID, err)
+	}
+
+	return nil
+}
+
+func (sm *SecretsManager) CreateTLSContext(orgID, envID, groupID string, context TLSContext) (string, error) {
+	var response secretCreated
+
+	log.Printf("Creating TLS context [%s] in secret group %s", context.Name, groupID)
+
+	err := sm.auth.client.POST(context, secretsPath(SECRET_GROUP_SECRETS, orgID, envID, groupID, SecretTypeTLSContext, ""), &response)
+
+	if err != nil {
+		return "", fmt.Errorf("error while creating TLS context %s : %s", context.Name, err)
+	}
+
+	return response.ID, nil
+}
+
+//GetTLSContext returns the TLS context with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (sm *SecretsManager) GetTLSContext(orgID, envID, groupID, contextID string) (TLSContext, error) {
+	var response TLSContext
+
+	err := sm.auth.client.GET(secretsPath(SECRET_GROUP_SECRET, orgID, envID, groupID, SecretTypeTLSContext, contextID), &response)
+
+	if err != nil {
+		return TLSContext{}, err
+	}
+
+	return response, nil
+}
+
+func (sm *SecretsManager) UpdateTLSContext(orgID, envID, groupID, contextID string, context TLSContext) error {
+	resp := new(interface{})
+
+	err := sm.auth.client.PUT(context, secretsPath(SECRET_GROUP_SECRET, orgID, envID, groupID, SecretTypeTLSContext, contextID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while updating TLS context %s : %s", contextID, err)
+	}
+
+	return nil
+}
+
+//DeleteSecret deletes a secret of the group. secretType is one of the SecretType constants
+func (sm *SecretsManager) DeleteSecret(orgID, envID, groupID, secretType, secretID string) error {
+	resp := new(interface{})
+
+	err := sm.auth.client.DELETE(nil, secretsPath(SECRET_GROUP_SECRET, orgID, envID, groupID, secretType, secretID), &resp)
+
+	if err != nil {
+		return fmt.Errorf("error while deleting %s %s : %s", secretType, secretID, err)
+	}
+
+	return nil
+}
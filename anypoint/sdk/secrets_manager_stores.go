@@ -0,0 +1,180 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+//CreateKeystore uploads the keystore files and returns the ID of the new keystore
+func (sm *SecretsManager) CreateKeystore(orgID, envID, groupID string, keystore Keystore) (string, error) {
+	return sm.uploadSecret(orgID, envID, groupID, SecretTypeKeystore, "", keystore.Name, keystoreFields(keystore), keystoreFiles(keystore))
+}
+
+//UpdateKeystore replaces the content of the keystore. Every file has to be uploaded again
+func (sm *SecretsManager) UpdateKeystore(orgID, envID, groupID, keystoreID string, keystore Keystore) error {
+	_, err := sm.uploadSecret(orgID, envID, groupID, SecretTypeKeystore, keystoreID, keystore.Name, keystoreFields(keystore), keystoreFiles(keystore))
+	return err
+}
+
+//GetKeystore returns the keystore with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (sm *SecretsManager) GetKeystore(orgID, envID, groupID, keystoreID string) (Keystore, error) {
+	var response Keystore
+
+	err := sm.auth.client.GET(secretsPath(SECRET_GROUP_SECRET, orgID, envID, groupID, SecretTypeKeystore, keystoreID), &response)
+
+	if err != nil {
+		return Keystore{}, err
+	}
+
+	return response, nil
+}
+
+func (sm *SecretsManager) CreateTruststore(orgID, envID, groupID string, truststore Truststore) (string, error) {
+	return sm.uploadSecret(orgID, envID, groupID, SecretTypeTruststore, "", truststore.Name, truststoreFields(truststore),
+		map[string]string{"trustStore": truststore.FilePath})
+}
+
+func (sm *SecretsManager) UpdateTruststore(orgID, envID, groupID, truststoreID string, truststore Truststore) error {
+	_, err := sm.uploadSecret(orgID, envID, groupID, SecretTypeTruststore, truststoreID, truststore.Name, truststoreFields(truststore),
+		map[string]string{"trustStore": truststore.FilePath})
+	return err
+}
+
+//GetTruststore returns the truststore with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (sm *SecretsManager) GetTruststore(orgID, envID, groupID, truststoreID string) (Truststore, error) {
+	var response Truststore
+
+	err := sm.auth.client.GET(secretsPath(SECRET_GROUP_SECRET, orgID, envID, groupID, SecretTypeTruststore, truststoreID), &response)
+
+	if err != nil {
+		return Truststore{}, err
+	}
+
+	return response, nil
+}
+
+func (sm *SecretsManager) CreateCertificate(orgID, envID, groupID string, certificate Certificate) (string, error) {
+	return sm.uploadSecret(orgID, envID, groupID, SecretTypeCertificate, "", certificate.Name,
+		map[string]string{"name": certificate.Name, "type": certificate.Type}, map[string]string{"certStore": certificate.FilePath})
+}
+
+func (sm *SecretsManager) UpdateCertificate(orgID, envID, groupID, certificateID string, certificate Certificate) error {
+	_, err := sm.uploadSecret(orgID, envID, groupID, SecretTypeCertificate, certificateID, certificate.Name,
+		map[string]string{"name": certificate.Name, "type": certificate.Type}, map[string]string{"certStore": certificate.FilePath})
+	return err
+}
+
+//GetCertificate returns the certificate with the given ID. Errors are returned as they come from
+//the RestClient so that callers can check IsNotFound
+func (sm *SecretsManager) GetCertificate(orgID, envID, groupID, certificateID string) (Certificate, error) {
+	var response Certificate
+
+	err := sm.auth.client.GET(secretsPath(SECRET_GROUP_SECRET, orgID, envID, groupID, SecretTypeCertificate, certificateID), &response)
+
+	if err != nil {
+		return Certificate{}, err
+	}
+
+	return response, nil
+}
+
+//uploadSecret sends the fields and the files, keyed by their form parameter, as a multipart request.
+//The secret is created when secretID is empty and replaced otherwise
+func (sm *SecretsManager) uploadSecret(orgID, envID, groupID, secretType, secretID, name string, fields, files map[string]string) (string, error) {
+	var response secretCreated
+
+	body := MultipartBody{
+		Fields: fields,
+	}
+
+	for param, path := range files {
+		if path == "" {
+			continue
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return "", fmt.Errorf("unable to open %s : %s", path, err)
+		}
+		defer file.Close()
+
+		body.Files = append(body.Files, MultipartFile{
+			Param:       param,
+			FileName:    filepath.Base(path),
+			ContentType: Application_OctetStream,
+			Reader:      file,
+		})
+	}
+
+	if secretID == "" {
+		log.Printf("Uploading %s [%s] to secret group %s", secretType, name, groupID)
+
+		err := sm.auth.client.POSTWithContentType(body, secretsPath(SECRET_GROUP_SECRETS, orgID, envID, groupID, secretType, ""), Multipart_Form_Data, &response)
+
+		if err != nil {
+			return "", fmt.Errorf("error while uploading %s %s : %s", secretType, name, err)
+		}
+
+		return response.ID, nil
+	}
+
+	log.Printf("Replacing %s [%s] in secret group %s", secretType, secretID, groupID)
+
+	err := sm.auth.client.PUTWithContentType(body, secretsPath(SECRET_GROUP_SECRET, orgID, envID, groupID, secretType, secretID), Multipart_Form_Data, &response)
+
+	if err != nil {
+		return "", fmt.Errorf("error while replacing %s %s : %s", secretType, secretID, err)
+	}
+
+	return secretID, nil
+}
+
+func keystoreFields(keystore Keystore) map[string]string {
+	fields := map[string]string{
+		"name": keystore.Name,
+		"type": keystore.Type,
+	}
+
+	if keystore.Alias != "" {
+		fields["alias"] = keystore.Alias
+	}
+
+	if keystore.StorePassphrase != "" {
+		fields["storePassphrase"] = keystore.StorePassphrase
+	}
+
+	if keystore.KeyPassphrase != "" {
+		fields["keyPassphrase"] = keystore.KeyPassphrase
+	}
+
+	return fields
+}
+
+func keystoreFiles(keystore Keystore) map[string]string {
+	if keystore.Type == StoreTypePEM {
+		return map[string]string{
+			"certificate": keystore.CertificatePath,
+			"key":         keystore.KeyPath,
+			"capath":      keystore.CAPath,
+		}
+	}
+
+	return map[string]string{"keyStore": keystore.FilePath}
+}
+
+func truststoreFields(truststore Truststore) map[string]string {
+	fields := map[string]string{
+		"name": truststore.Name,
+		"type": truststore.Type,
+	}
+
+	if truststore.Passphrase != "" {
+		fields["passphrase"] = truststore.Passphrase
+	}
+
+	return fields
+}
@@ -0,0 +1,94 @@
+package sdk
+
+const (
+	SecretTypeKeystore    = "keystores"
+	SecretTypeTruststore  = "truststores"
+	SecretTypeCertificate = "certificates"
+	SecretTypeTLSContext  = "tlsContexts"
+
+	StoreTypeJKS    = "JKS"
+	StoreTypePKCS12 = "PKCS12"
+	StoreTypeJCEKS  = "JCEKS"
+	StoreTypePEM    = "PEM"
+
+	TLSContextTargetFlexGateway = "FlexGateway"
+	TLSContextTargetMule        = "Mule"
+)
+
+//SecretsManager manages the secret groups of an environment and the TLS material they hold
+type SecretsManager struct {
+	auth *AccessManagement
+}
+
+//SecretMeta identifies a secret. Path is what other secrets of the group use to reference it,
+//such as keystores/<id>
+type SecretMeta struct {
+	ID   string `json:"id,omitempty"`
+	Path string `json:"path,omitempty"`
+}
+
+type SecretGroup struct {
+	Meta         SecretMeta `json:"meta"`
+	Name         string     `json:"name"`
+	Downloadable bool       `json:"downloadable"`
+}
+
+//Keystore holds a private key and its certificate chain. JKS, PKCS12 and JCEKS keystores are uploaded from
+//FilePath, PEM ones from CertificatePath and KeyPath. Passphrases and paths are never returned by the API
+type Keystore struct {
+	Meta            SecretMeta `json:"meta"`
+	Name            string     `json:"name"`
+	Type            string     `json:"type"`
+	Alias           string     `json:"alias,omitempty"`
+	ExpirationDate  string     `json:"expirationDate,omitempty"`
+	StorePassphrase string     `json:"-"`
+	KeyPassphrase   string     `json:"-"`
+	FilePath        string     `json:"-"`
+	CertificatePath string     `json:"-"`
+	KeyPath         string     `json:"-"`
+	CAPath          string     `json:"-"`
+}
+
+//Truststore holds the certificates trusted when validating peers
+type Truststore struct {
+	Meta           SecretMeta `json:"meta"`
+	Name           string     `json:"name"`
+	Type           string     `json:"type"`
+	ExpirationDate string     `json:"expirationDate,omitempty"`
+	Passphrase     string     `json:"-"`
+	FilePath       string     `json:"-"`
+}
+
+//Certificate is a single PEM certificate
+type Certificate struct {
+	Meta           SecretMeta `json:"meta"`
+	Name           string     `json:"name"`
+	Type           string     `json:"type"`
+	ExpirationDate string     `json:"expirationDate,omitempty"`
+	FilePath       string     `json:"-"`
+}
+
+//TLSContext combines a keystore and a truststore of the same group with the protocol settings
+//used by its target
+type TLSContext struct {
+	Meta                       SecretMeta `json:"meta"`
+	Name                       string     `json:"name"`
+	Target                     string     `json:"target"`
+	Keystore                   *SecretRef `json:"keystore,omitempty"`
+	Truststore                 *SecretRef `json:"truststore,omitempty"`
+	MinTLSVersion              string     `json:"minTlsVersion,omitempty"`
+	MaxTLSVersion              string     `json:"maxTlsVersion,omitempty"`
+	CipherSuites               []string   `json:"cipherSuites,omitempty"`
+	ALPNProtocols              []string   `json:"alpnProtocols,omitempty"`
+	EnableClientCertValidation bool       `json:"enableClientCertValidation"`
+	SkipServerCertValidation   bool       `json:"skipServerCertValidation"`
+}
+
+//SecretRef points to another secret of the same group through its SecretMeta.Path
+type SecretRef struct {
+	Path string `json:"path"`
+}
+
+type secretCreated struct {
+	ID string `json:"id"`
+}
@@ -1,17 +1,19 @@
 package sdk
 
 type Endpoint struct {
-	Id                   int    `json:"id"`
-	OrgID                string `json:"masterOrganizationId"`
-	ApiID                int
-	VersionID            int    `json:"apiVersionId"`
+	Id                   int    `json:"id,omitempty"`
+	OrgID                string `json:"masterOrganizationId,omitempty"`
+	ApiID                int    `json:"apiId,omitempty"`
+	VersionID            int    `json:"apiVersionId,omitempty"`
 	Type                 string `json:"type"`
 	Uri                  string `json:"uri"`
-	ProxyUri             string `json:"proxyUri"`
-	ProxyRegistrationUri string `json:"proxyRegistrationUri"`
+	ProxyUri             string `json:"proxyUri,omitempty"`
+	ProxyRegistrationUri string `json:"proxyRegistrationUri,omitempty"`
 	IsCloudHub           bool   `json:"isCloudHub"`
 	ReferencesUserDomain bool   `json:"referencesUserDomain"`
-	ResponseTimeout      int    `json:"responseTimeout"`
+	ResponseTimeout      int    `json:"responseTimeout,omitempty"`
+	MuleVersion4OrAbove  bool   `json:"muleVersion4OrAbove"`
+	DeploymentType       string `json:"deploymentType,omitempty"`
 }
 
 type ClusterServer struct {
@@ -1,6 +1,10 @@
 package sdk
 
-import "strings"
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
 
 const (
 	BASE_URI     = "/accounts/api"
@@ -9,6 +13,89 @@ const (
 	ORGANIZATION = BASE_URI + "/organizations/{orgId}"
 	HIERARCHY    = ORGANIZATION + "/hierarchy"
 	SEARCH_USER  = ORGANIZATION + "/members"
+	ENVIRONMENTS = ORGANIZATION + "/environments"
+	ENVIRONMENT  = ENVIRONMENTS + "/{envId}"
+
+	HYBRID_BASE_URI     = "/hybrid/api/v1"
+	HYBRID_APPLICATIONS = HYBRID_BASE_URI + "/applications"
+	HYBRID_APPLICATION  = HYBRID_APPLICATIONS + "/{appId}"
+
+	FLEX_GATEWAYS             = "/standalone/api/v1/organizations/{orgId}/environments/{envId}/gateways"
+	FLEX_GATEWAY              = FLEX_GATEWAYS + "/{gatewayId}"
+	FLEX_GATEWAY_REGISTRATION = FLEX_GATEWAY + "/registration"
+
+	RUNTIME_ALERTS = "/armui/api/v1/alerts"
+	RUNTIME_ALERT  = RUNTIME_ALERTS + "/{alertId}"
+
+	AMC_BASE_URI    = "/amc/application-manager/api/v2/organizations/{orgId}/environments/{envId}"
+	AMC_DEPLOYMENTS = AMC_BASE_URI + "/deployments"
+	AMC_DEPLOYMENT  = AMC_DEPLOYMENTS + "/{deploymentId}"
+
+	RTF_BASE_URI        = "/runtimefabric/api/organizations/{orgId}"
+	PRIVATE_SPACES      = RTF_BASE_URI + "/privatespaces"
+	PRIVATE_SPACE       = PRIVATE_SPACES + "/{spaceId}"
+	FABRICS             = RTF_BASE_URI + "/fabrics"
+	FABRIC              = FABRICS + "/{fabricId}"
+	FABRIC_ASSOCIATIONS = FABRIC + "/associations"
+
+	CLOUDHUB_BASE_URI = "/cloudhub/api/organizations/{orgId}"
+	CLOUDHUB_VPCS     = CLOUDHUB_BASE_URI + "/vpcs"
+	CLOUDHUB_VPC      = CLOUDHUB_VPCS + "/{vpcId}"
+	LOAD_BALANCERS    = CLOUDHUB_VPC + "/loadbalancers"
+	LOAD_BALANCER     = LOAD_BALANCERS + "/{lbId}"
+	VPNS              = CLOUDHUB_VPC + "/ipsec"
+	VPN               = VPNS + "/{vpnId}"
+
+	STATIC_IPS = "/cloudhub/api/staticips"
+	STATIC_IP  = STATIC_IPS + "/{address}"
+
+	CLOUDHUB_APPLICATIONS = "/cloudhub/api/v2/applications"
+	CLOUDHUB_APPLICATION  = CLOUDHUB_APPLICATIONS + "/{domain}"
+
+	API_MANAGER_BASE_URI = "/apimanager/api/v1/organizations/{orgId}/environments/{envId}"
+	API_INSTANCES        = API_MANAGER_BASE_URI + "/apis"
+	API_INSTANCE         = API_INSTANCES + "/{apiId}"
+	API_DEPLOYMENTS      = API_INSTANCE + "/deployments"
+	API_POLICIES         = API_INSTANCE + "/policies"
+	API_POLICY           = API_POLICIES + "/{policyId}"
+	API_TIERS            = API_INSTANCE + "/tiers"
+	API_TIER             = API_TIERS + "/{tierId}"
+	API_CONTRACTS        = API_INSTANCE + "/contracts"
+	API_CONTRACT         = API_CONTRACTS + "/{contractId}"
+	API_ALERTS           = API_INSTANCE + "/alerts"
+	API_ALERT            = API_ALERTS + "/{alertId}"
+
+	EXCHANGE_BASE_URI            = "/exchange/api/v1/organizations/{orgId}"
+	CLIENT_APPLICATIONS          = EXCHANGE_BASE_URI + "/applications"
+	CLIENT_APPLICATION           = CLIENT_APPLICATIONS + "/{appId}"
+	CLIENT_APPLICATION_CONTRACTS = CLIENT_APPLICATION + "/contracts"
+	EXCHANGE_PUBLISH             = "/exchange/api/v1/assets"
+	EXCHANGE_ORG_ASSET           = EXCHANGE_BASE_URI + "/assets/{groupId}/{assetId}/{version}"
+	EXCHANGE_ASSET_TAGS          = EXCHANGE_ORG_ASSET + "/tags"
+	EXCHANGE_ASSET_LATEST        = "/exchange/api/v2/assets/{groupId}/{assetId}"
+	EXCHANGE_ASSET               = EXCHANGE_ASSET_LATEST + "/{version}"
+	EXCHANGE_ASSET_STATUS        = EXCHANGE_ASSET + "/status"
+	EXCHANGE_ASSET_CATEGORY      = EXCHANGE_ASSET + "/tags/categories/{categoryKey}"
+
+	MQ_ENV_BASE_URI  = "/mq/admin/api/v1/organizations/{orgId}/environments/{envId}"
+	MQ_BASE_URI      = MQ_ENV_BASE_URI + "/regions/{regionId}"
+	MQ_QUEUE         = MQ_BASE_URI + "/destinations/queues/{queueId}"
+	MQ_EXCHANGE      = MQ_BASE_URI + "/destinations/exchanges/{exchangeId}"
+	MQ_BINDING       = MQ_BASE_URI + "/bindings/exchanges/{exchangeId}/queues/{queueId}"
+	MQ_BINDING_RULES = MQ_BINDING + "/rules/routing"
+	MQ_CLIENTS       = MQ_ENV_BASE_URI + "/clients"
+	MQ_CLIENT        = MQ_CLIENTS + "/{clientId}"
+	MQ_CLIENT_SECRET = MQ_CLIENT + "/regenerateSecret"
+
+	OBJECT_STORE_HOST       = "object-store-{regionId}."
+	OBJECT_STORE_BASE_URI   = "/api/v1/organizations/{orgId}/environments/{envId}/stores/{storeId}"
+	OBJECT_STORE_PARTITIONS = OBJECT_STORE_BASE_URI + "/partitions"
+	OBJECT_STORE_KEY        = OBJECT_STORE_PARTITIONS + "/{partitionId}/keys/{keyId}"
+
+	SECRET_GROUPS        = "/secrets-manager/api/v1/organizations/{orgId}/environments/{envId}/secretGroups"
+	SECRET_GROUP         = SECRET_GROUPS + "/{secretGroupId}"
+	SECRET_GROUP_SECRETS = SECRET_GROUP + "/{secretType}"
+	SECRET_GROUP_SECRET  = SECRET_GROUP_SECRETS + "/{secretId}"
 )
 
 func hierarchyPath(orgId string) string {
@@ -22,3 +109,176 @@ func searchUserPath(orgId string) string {
 func organizationPath(orgId string) string {
 	return strings.Replace(ORGANIZATION, "{orgId}", orgId, -1)
 }
+
+func environmentsPath(orgId string) string {
+	return strings.Replace(ENVIRONMENTS, "{orgId}", orgId, -1)
+}
+
+func envPath(template, orgId, envId string) string {
+	return strings.NewReplacer("{orgId}", orgId, "{envId}", envId).Replace(template)
+}
+
+func flexGatewayPath(template, orgId, envId, gatewayId string) string {
+	return strings.Replace(envPath(template, orgId, envId), "{gatewayId}", gatewayId, -1)
+}
+
+func runtimeAlertPath(alertId string) string {
+	return strings.Replace(RUNTIME_ALERT, "{alertId}", alertId, -1)
+}
+
+func amcDeploymentsPath(orgId, envId string) string {
+	return envPath(AMC_DEPLOYMENTS, orgId, envId)
+}
+
+func amcDeploymentPath(orgId, envId, deploymentId string) string {
+	return strings.Replace(envPath(AMC_DEPLOYMENT, orgId, envId), "{deploymentId}", deploymentId, -1)
+}
+
+func privateSpacesPath(orgId string) string {
+	return strings.Replace(PRIVATE_SPACES, "{orgId}", orgId, -1)
+}
+
+func privateSpacePath(orgId, spaceId string) string {
+	return strings.NewReplacer("{orgId}", orgId, "{spaceId}", spaceId).Replace(PRIVATE_SPACE)
+}
+
+//fabricPath fills in the fabric templates. fabricId is ignored by FABRICS
+func fabricPath(template, orgId, fabricId string) string {
+	return strings.NewReplacer("{orgId}", orgId, "{fabricId}", fabricId).Replace(template)
+}
+
+func vpcsPath(orgId string) string {
+	return strings.Replace(CLOUDHUB_VPCS, "{orgId}", orgId, -1)
+}
+
+func vpcPath(orgId, vpcId string) string {
+	return strings.NewReplacer("{orgId}", orgId, "{vpcId}", vpcId).Replace(CLOUDHUB_VPC)
+}
+
+func loadBalancersPath(orgId, vpcId string) string {
+	return strings.NewReplacer("{orgId}", orgId, "{vpcId}", vpcId).Replace(LOAD_BALANCERS)
+}
+
+func loadBalancerPath(orgId, vpcId, lbId string) string {
+	return strings.NewReplacer("{orgId}", orgId, "{vpcId}", vpcId, "{lbId}", lbId).Replace(LOAD_BALANCER)
+}
+
+func vpnsPath(orgId, vpcId string) string {
+	return strings.NewReplacer("{orgId}", orgId, "{vpcId}", vpcId).Replace(VPNS)
+}
+
+func vpnPath(orgId, vpcId, vpnId string) string {
+	return strings.NewReplacer("{orgId}", orgId, "{vpcId}", vpcId, "{vpnId}", vpnId).Replace(VPN)
+}
+
+func cloudHubApplicationPath(domain string) string {
+	return strings.Replace(CLOUDHUB_APPLICATION, "{domain}", domain, -1)
+}
+
+func staticIPPath(address string) string {
+	return strings.Replace(STATIC_IP, "{address}", address, -1)
+}
+
+func apiInstancesPath(orgId, envId string) string {
+	return envPath(API_INSTANCES, orgId, envId)
+}
+
+func apiInstancePath(orgId, envId string, apiId int) string {
+	return apiPath(API_INSTANCE, orgId, envId, apiId)
+}
+
+func apiDeploymentsPath(orgId, envId string, apiId int) string {
+	return apiPath(API_DEPLOYMENTS, orgId, envId, apiId)
+}
+
+func apiPoliciesPath(orgId, envId string, apiId int) string {
+	return apiPath(API_POLICIES, orgId, envId, apiId)
+}
+
+func apiPolicyPath(orgId, envId string, apiId, policyId int) string {
+	return strings.Replace(apiPath(API_POLICY, orgId, envId, apiId), "{policyId}", strconv.Itoa(policyId), -1)
+}
+
+func apiTiersPath(orgId, envId string, apiId int) string {
+	return apiPath(API_TIERS, orgId, envId, apiId)
+}
+
+func apiTierPath(orgId, envId string, apiId, tierId int) string {
+	return strings.Replace(apiPath(API_TIER, orgId, envId, apiId), "{tierId}", strconv.Itoa(tierId), -1)
+}
+
+func apiContractPath(orgId, envId string, apiId, contractId int) string {
+	return strings.Replace(apiPath(API_CONTRACT, orgId, envId, apiId), "{contractId}", strconv.Itoa(contractId), -1)
+}
+
+func apiAlertsPath(orgId, envId string, apiId int) string {
+	return apiPath(API_ALERTS, orgId, envId, apiId)
+}
+
+func apiAlertPath(orgId, envId string, apiId int, alertId string) string {
+	return strings.Replace(apiPath(API_ALERT, orgId, envId, apiId), "{alertId}", alertId, -1)
+}
+
+func apiPath(template, orgId, envId string, apiId int) string {
+	return strings.Replace(envPath(template, orgId, envId), "{apiId}", strconv.Itoa(apiId), -1)
+}
+
+func clientApplicationsPath(orgId string) string {
+	return strings.Replace(CLIENT_APPLICATIONS, "{orgId}", orgId, -1)
+}
+
+func clientApplicationPath(orgId string, appId int) string {
+	return strings.NewReplacer("{orgId}", orgId, "{appId}", strconv.Itoa(appId)).Replace(CLIENT_APPLICATION)
+}
+
+func clientApplicationContractsPath(orgId string, appId int) string {
+	return strings.NewReplacer("{orgId}", orgId, "{appId}", strconv.Itoa(appId)).Replace(CLIENT_APPLICATION_CONTRACTS)
+}
+
+//exchangeAssetPath fills in the coordinates of an asset. The group ID of the assets we publish is
+//always the ID of their business group, so it is also used as {orgId}
+func exchangeAssetPath(template, groupId, assetId, version string) string {
+	return strings.NewReplacer("{orgId}", groupId, "{groupId}", groupId, "{assetId}", assetId, "{version}", version).Replace(template)
+}
+
+//mqPath fills in the region scoped MQ templates. IDs not used by the template are ignored
+func mqPath(template, orgId, envId, regionId, exchangeId, queueId string) string {
+	return strings.NewReplacer(
+		"{orgId}", orgId,
+		"{envId}", envId,
+		"{regionId}", regionId,
+		"{exchangeId}", exchangeId,
+		"{queueId}", queueId,
+	).Replace(template)
+}
+
+func mqClientPath(template, orgId, envId, clientId string) string {
+	return strings.NewReplacer("{orgId}", orgId, "{envId}", envId, "{clientId}", clientId).Replace(template)
+}
+
+func hybridApplicationPath(appId int) string {
+	return strings.Replace(HYBRID_APPLICATION, "{appId}", strconv.Itoa(appId), -1)
+}
+
+//objectStorePath fills in the Object Store templates. Partition and key IDs are escaped since apps are free
+//to use any character in them
+func objectStorePath(template, orgId, envId, storeId, partitionId, keyId string) string {
+	return strings.NewReplacer(
+		"{orgId}", orgId,
+		"{envId}", envId,
+		"{storeId}", url.PathEscape(storeId),
+		"{partitionId}", url.PathEscape(partitionId),
+		"{keyId}", url.PathEscape(keyId),
+	).Replace(template)
+}
+
+//secretsPath fills in the Secrets Manager templates. secretType is the collection of the secret, such as keystores
+func secretsPath(template, orgId, envId, secretGroupId, secretType, secretId string) string {
+	return strings.NewReplacer(
+		"{orgId}", orgId,
+		"{envId}", envId,
+		"{secretGroupId}", secretGroupId,
+		"{secretType}", secretType,
+		"{secretId}", secretId,
+	).Replace(template)
+}
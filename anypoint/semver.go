@@ -0,0 +1,242 @@
+package anypoint
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//semver is a parsed semantic version. Build metadata is ignored
+type semver struct {
+	major, minor, patch int
+	prerelease          string
+}
+
+func parseSemver(v string) (semver, error) {
+	var s semver
+
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.Index(v, "+"); i >= 0 {
+		v = v[:i]
+	}
+	if i := strings.Index(v, "-"); i >= 0 {
+		s.prerelease = v[i+1:]
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return semver{}, fmt.Errorf("%q is not a semantic version", v)
+	}
+
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return semver{}, fmt.Errorf("%q is not a semantic version", v)
+		}
+		numbers[i] = n
+	}
+
+	s.major, s.minor, s.patch = numbers[0], numbers[1], numbers[2]
+
+	return s, nil
+}
+
+//nextPatchVersion returns the version following v when only its patch number is bumped. A pre-release is
+//bumped to the next patch release
+func nextPatchVersion(v string) (string, error) {
+	s, err := parseSemver(v)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d.%d.%d", s.major, s.minor, s.patch+1), nil
+}
+
+//compare returns -1, 0 or 1. Pre-releases sort before their release and between themselves lexically
+func (s semver) compare(o semver) int {
+	for _, diff := range []int{s.major - o.major, s.minor - o.minor, s.patch - o.patch} {
+		if diff < 0 {
+			return -1
+		}
+		if diff > 0 {
+			return 1
+		}
+	}
+
+	switch {
+	case s.prerelease == o.prerelease:
+		return 0
+	case s.prerelease == "":
+		return 1
+	case o.prerelease == "":
+		return -1
+	case s.prerelease < o.prerelease:
+		return -1
+	}
+
+	return 1
+}
+
+//semverComparator is a single condition of a constraint, such as >=1.2.0
+type semverComparator struct {
+	op      string
+	version semver
+}
+
+func (c semverComparator) matches(v semver) bool {
+	cmp := v.compare(c.version)
+
+	switch c.op {
+	case "=":
+		return cmp == 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+
+	return false
+}
+
+//semverConstraint is a space separated list of comparators that must all match. It supports the npm style
+//shorthands ^1.2.3, ~1.2.3, 1.2.x, 1.x and *
+type semverConstraint []semverComparator
+
+func parseSemverConstraint(constraint string) (semverConstraint, error) {
+	var result semverConstraint
+
+	for _, term := range strings.Fields(constraint) {
+		comparators, err := parseSemverTerm(term)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q : %s", constraint, err)
+		}
+		result = append(result, comparators...)
+	}
+
+	return result, nil
+}
+
+func parseSemverTerm(term string) ([]semverComparator, error) {
+	if term == "*" || term == "x" || term == "latest" {
+		return nil, nil
+	}
+
+	for _, op := range []string{">=", "<=", ">", "<", "="} {
+		if strings.HasPrefix(term, op) {
+			v, err := parseSemver(term[len(op):])
+			if err != nil {
+				return nil, err
+			}
+			return []semverComparator{{op, v}}, nil
+		}
+	}
+
+	switch term[0] {
+	case '^':
+		v, err := parseSemver(term[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		//^ allows changes that do not modify the left-most non-zero number
+		upper := semver{major: v.major + 1}
+		if v.major == 0 && v.minor > 0 {
+			upper = semver{minor: v.minor + 1}
+		} else if v.major == 0 {
+			upper = semver{patch: v.patch + 1}
+		}
+		return []semverComparator{{">=", v}, {"<", upper}}, nil
+	case '~':
+		v, err := parseSemver(term[1:])
+		if err != nil {
+			return nil, err
+		}
+		return []semverComparator{{">=", v}, {"<", semver{major: v.major, minor: v.minor + 1}}}, nil
+	}
+
+	//Partial versions: 1, 1.x, 1.2, 1.2.x
+	parts := strings.Split(term, ".")
+	if len(parts) < 3 || parts[2] == "x" || parts[2] == "*" {
+		numbers := []int{}
+		for _, part := range parts {
+			if part == "x" || part == "*" {
+				break
+			}
+			n, err := strconv.Atoi(part)
+			if err != nil {
+				return nil, fmt.Errorf("%q is not a version range", term)
+			}
+			numbers = append(numbers, n)
+		}
+
+		switch len(numbers) {
+		case 0:
+			return nil, nil
+		case 1:
+			return []semverComparator{{">=", semver{major: numbers[0]}}, {"<", semver{major: numbers[0] + 1}}}, nil
+		case 2:
+			return []semverComparator{{">=", semver{major: numbers[0], minor: numbers[1]}}, {"<", semver{major: numbers[0], minor: numbers[1] + 1}}}, nil
+		}
+	}
+
+	v, err := parseSemver(term)
+	if err != nil {
+		return nil, err
+	}
+
+	return []semverComparator{{"=", v}}, nil
+}
+
+//allows reports whether the version satisfies every comparator. Pre-releases are only allowed when
+//a comparator explicitly names one with the same major, minor and patch
+func (c semverConstraint) allows(v semver) bool {
+	prereleaseAllowed := v.prerelease == ""
+
+	for _, comparator := range c {
+		if !comparator.matches(v) {
+			return false
+		}
+
+		cv := comparator.version
+		if cv.prerelease != "" && cv.major == v.major && cv.minor == v.minor && cv.patch == v.patch {
+			prereleaseAllowed = true
+		}
+	}
+
+	return prereleaseAllowed
+}
+
+//highestMatchingVersion returns the highest of the versions satisfying the constraint. Versions that are not
+//semantic versions are ignored
+func highestMatchingVersion(constraint string, versions []string) (string, error) {
+	c, err := parseSemverConstraint(constraint)
+	if err != nil {
+		return "", err
+	}
+
+	best := ""
+	var bestVersion semver
+
+	for _, version := range versions {
+		v, err := parseSemver(version)
+		if err != nil || !c.allows(v) {
+			continue
+		}
+
+		if best == "" || v.compare(bestVersion) > 0 {
+			best, bestVersion = version, v
+		}
+	}
+
+	if best == "" {
+		return "", fmt.Errorf("no version matches %q among %s", constraint, strings.Join(versions, ", "))
+	}
+
+	return best, nil
+}
@@ -0,0 +1,73 @@
+package anypoint
+
+import (
+	"testing"
+)
+
+func TestHighestMatchingVersion(t *testing.T) {
+	versions := []string{"0.1.0", "0.1.5", "0.2.0", "1.0.0", "1.2.0", "1.2.7", "1.3.0-beta.1", "1.10.1", "2.0.0", "2.1.0-rc.1", "snapshot"}
+
+	cases := []struct {
+		constraint string
+		expected   string
+	}{
+		{"^1.2.0", "1.10.1"},
+		{"~1.2.0", "1.2.7"},
+		{"^0.1.0", "0.1.5"},
+		{"1.2.x", "1.2.7"},
+		{"1.x", "1.10.1"},
+		{"1", "1.10.1"},
+		{"*", "2.0.0"},
+		{"1.2.0", "1.2.0"},
+		{">=1.0.0 <1.10.0", "1.2.7"},
+		{">1.2.7 <2.0.0", "1.10.1"},
+		{">=1.3.0-beta.1 <1.3.0", "1.3.0-beta.1"},
+		{"2.1.0-rc.1", "2.1.0-rc.1"},
+	}
+
+	for _, c := range cases {
+		actual, err := highestMatchingVersion(c.constraint, versions)
+
+		if err != nil {
+			t.Errorf("%s: unexpected error %s", c.constraint, err)
+			continue
+		}
+
+		if actual != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.constraint, c.expected, actual)
+		}
+	}
+}
+
+func TestHighestMatchingVersion_errors(t *testing.T) {
+	versions := []string{"1.0.0", "1.1.0"}
+
+	for _, constraint := range []string{"^2.0.0", "~1.2.0", "1.0.1", ">=1.1.1", "^a.b.c", ">=1.0"} {
+		if v, err := highestMatchingVersion(constraint, versions); err == nil {
+			t.Errorf("%s: expected an error, got %s", constraint, v)
+		}
+	}
+}
+
+func TestNextPatchVersion(t *testing.T) {
+	cases := map[string]string{
+		"1.0.0":        "1.0.1",
+		"v2.3.9":       "2.3.10",
+		"1.3.0-beta.1": "1.3.1",
+	}
+
+	for version, expected := range cases {
+		actual, err := nextPatchVersion(version)
+		if err != nil {
+			t.Errorf("unexpected error for %s : %s", version, err)
+		}
+
+		if actual != expected {
+			t.Errorf("expected %s after %s, got %s", expected, version, actual)
+		}
+	}
+
+	if _, err := nextPatchVersion("snapshot"); err == nil {
+		t.Error("expected an error for a version that is not semantic")
+	}
+}
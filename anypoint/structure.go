@@ -0,0 +1,19 @@
+package anypoint
+
+//expandStringMap converts a TypeMap value into the map expected by the sdk
+func expandStringMap(v interface{}) map[string]string {
+	m := make(map[string]string)
+	for k, val := range v.(map[string]interface{}) {
+		m[k] = val.(string)
+	}
+	return m
+}
+
+//expandStringList converts a TypeList or a TypeSet (as a list) of strings into a slice
+func expandStringList(v []interface{}) []string {
+	l := make([]string, 0, len(v))
+	for _, val := range v {
+		l = append(l, val.(string))
+	}
+	return l
+}